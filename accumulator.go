@@ -0,0 +1,202 @@
+package bn128_bls
+
+// Sorted Merkle Accumulator Over Committee PubKeys, Supporting Membership And Non-Membership Proofs.
+// Construction:
+//   - Key Of Member Is PubKeyIdentifier(pubKey), Keys Are Sorted Ascending And Must Be Distinct.
+//   - Leaf Is Keccak256(0x00 || key), Internal Node Is Keccak256(0x01 || left || right), Odd Node Is Carried Up Unchanged.
+//     Unlike SignaturesMerkleRoot Children Are Not Sorted, Position Of Leaf Is Bound By Proof.
+//   - Root Is Keccak256(0x02 || uint64 Count || treeRoot), treeRoot Of Empty Committee Is 32 Zero Bytes.
+//
+// Non-Membership Of Candidate Key k Is Proven By Adjacent Leaves At Positions i-1 And i With key[i-1] < k < key[i],
+// At Boundaries Only key[0] (With k < key[0]) Or key[Count-1] (With k > key[Count-1]) Is Given,
+// Committing To Count Lets Verifier Check That Boundary Leaf Is Really First Or Last.
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+var ErrCommitteeMember = errors.New("pubKey is committee member")
+
+// Only Root And Count Are Needed By VerifyNonMembership, So Accumulator Published Elsewhere
+// Can Be Rebuilt As &Accumulator{Root: root, Count: count}. Leaves Are Kept Only For Proving.
+type Accumulator struct {
+	Root   [32]byte
+	Count  uint64
+	leaves [][32]byte
+}
+
+// Inclusion Of Member Key At Leaf Position Index, Path Lists Siblings From Leaf Level Up, Carried Levels Are Skipped.
+type AccumulatorWitness struct {
+	Key   [32]byte
+	Index uint64
+	Path  [][32]byte
+}
+
+// Lower Is Greatest Member Key Below Candidate, Upper Is Smallest Above, Either Is Nil At Boundary.
+type NonMembershipProof struct {
+	Lower *AccumulatorWitness
+	Upper *AccumulatorWitness
+}
+
+func (bls *BLS) AccumulateCommittee(pubKeysG2 [][3][2]*big.Int) (*Accumulator, error) {
+	keys := make([][32]byte, len(pubKeysG2))
+	for i, pubKey := range pubKeysG2 {
+		if err := bls.ValidatePubKey(pubKey); err != nil {
+			return nil, fmt.Errorf("invalid pubKey at index %d: %w", i, err)
+		}
+		keys[i] = bls.PubKeyIdentifier(pubKey)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i][:], keys[j][:]) < 0
+	})
+	for i := 1; i < len(keys); i++ {
+		if keys[i-1] == keys[i] {
+			return nil, fmt.Errorf("duplicate committee member %x", keys[i])
+		}
+	}
+	return &Accumulator{
+		Root:   accumulatorRoot(uint64(len(keys)), accumulatorTreeRoot(keys)),
+		Count:  uint64(len(keys)),
+		leaves: keys,
+	}, nil
+}
+
+// Returns Proof That `candidate` Is Not In Committee, Or ErrCommitteeMember If It Is.
+// `acc` Must Come From AccumulateCommittee.
+func (bls *BLS) ProveNonMembership(acc *Accumulator, candidate [3][2]*big.Int) (*NonMembershipProof, error) {
+	if acc.leaves == nil && acc.Count != 0 {
+		return nil, fmt.Errorf("accumulator has no leaves, it must be built by AccumulateCommittee")
+	}
+	if err := bls.ValidatePubKey(candidate); err != nil {
+		return nil, fmt.Errorf("invalid candidate: %w", err)
+	}
+	key := bls.PubKeyIdentifier(candidate)
+	i := sort.Search(len(acc.leaves), func(i int) bool {
+		return bytes.Compare(acc.leaves[i][:], key[:]) >= 0
+	})
+	if i < len(acc.leaves) && acc.leaves[i] == key {
+		return nil, ErrCommitteeMember
+	}
+	proof := &NonMembershipProof{}
+	if i > 0 {
+		proof.Lower = accumulatorWitness(acc.leaves, i-1)
+	}
+	if i < len(acc.leaves) {
+		proof.Upper = accumulatorWitness(acc.leaves, i)
+	}
+	return proof, nil
+}
+
+// Checks `proof` Shows `candidate` Is Not Member Of Committee Committed To By `acc.Root` And `acc.Count`.
+func (bls *BLS) VerifyNonMembership(acc *Accumulator, candidate [3][2]*big.Int, proof *NonMembershipProof) (bool, error) {
+	if err := bls.ValidatePubKey(candidate); err != nil {
+		return false, fmt.Errorf("invalid candidate: %w", err)
+	}
+	if proof == nil {
+		return false, fmt.Errorf("proof is nil")
+	}
+	key := bls.PubKeyIdentifier(candidate)
+	lower, upper := proof.Lower, proof.Upper
+	switch {
+	case lower == nil && upper == nil:
+		return acc.Count == 0 && acc.Root == accumulatorRoot(0, [32]byte{}), nil
+	case lower == nil && upper.Index != 0:
+		return false, nil
+	case upper == nil && lower.Index != acc.Count-1:
+		return false, nil
+	case lower != nil && upper != nil && lower.Index+1 != upper.Index:
+		return false, nil
+	}
+	if lower != nil && (bytes.Compare(lower.Key[:], key[:]) >= 0 || !verifyAccumulatorWitness(acc, lower)) {
+		return false, nil
+	}
+	if upper != nil && (bytes.Compare(key[:], upper.Key[:]) >= 0 || !verifyAccumulatorWitness(acc, upper)) {
+		return false, nil
+	}
+	return true, nil
+}
+
+func accumulatorRoot(count uint64, treeRoot [32]byte) [32]byte {
+	return keccak256([]byte{0x02}, binary.BigEndian.AppendUint64(nil, count), treeRoot[:])
+}
+
+func accumulatorLeaf(key [32]byte) [32]byte {
+	return keccak256([]byte{0x00}, key[:])
+}
+
+func accumulatorNode(left, right [32]byte) [32]byte {
+	return keccak256([]byte{0x01}, left[:], right[:])
+}
+
+func accumulatorLeaves(keys [][32]byte) [][32]byte {
+	nodes := make([][32]byte, len(keys))
+	for i, key := range keys {
+		nodes[i] = accumulatorLeaf(key)
+	}
+	return nodes
+}
+
+func accumulatorParents(nodes [][32]byte) [][32]byte {
+	parents := make([][32]byte, 0, (len(nodes)+1)/2)
+	for i := 0; i+1 < len(nodes); i += 2 {
+		parents = append(parents, accumulatorNode(nodes[i], nodes[i+1]))
+	}
+	if len(nodes)%2 == 1 {
+		parents = append(parents, nodes[len(nodes)-1])
+	}
+	return parents
+}
+
+func accumulatorTreeRoot(keys [][32]byte) [32]byte {
+	if len(keys) == 0 {
+		return [32]byte{}
+	}
+	nodes := accumulatorLeaves(keys)
+	for len(nodes) > 1 {
+		nodes = accumulatorParents(nodes)
+	}
+	return nodes[0]
+}
+
+func accumulatorWitness(keys [][32]byte, index int) *AccumulatorWitness {
+	witness := &AccumulatorWitness{Key: keys[index], Index: uint64(index), Path: [][32]byte{}}
+	nodes := accumulatorLeaves(keys)
+	for len(nodes) > 1 {
+		if sibling := index ^ 1; sibling < len(nodes) {
+			witness.Path = append(witness.Path, nodes[sibling])
+		}
+		nodes = accumulatorParents(nodes)
+		index /= 2
+	}
+	return witness
+}
+
+// Recomputes Root From Witness, Level Sizes Follow From acc.Count So Carried Levels Are Known To Verifier.
+func verifyAccumulatorWitness(acc *Accumulator, witness *AccumulatorWitness) bool {
+	if witness.Index >= acc.Count {
+		return false
+	}
+	node := accumulatorLeaf(witness.Key)
+	index, size, path := witness.Index, acc.Count, witness.Path
+	for size > 1 {
+		if sibling := index ^ 1; sibling < size {
+			if len(path) == 0 {
+				return false
+			}
+			if index%2 == 0 {
+				node = accumulatorNode(node, path[0])
+			} else {
+				node = accumulatorNode(path[0], node)
+			}
+			path = path[1:]
+		}
+		index /= 2
+		size = (size + 1) / 2
+	}
+	return len(path) == 0 && accumulatorRoot(acc.Count, node) == acc.Root
+}
@@ -0,0 +1,82 @@
+package bn128_bls
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestAccumulatorNonMembership(t *testing.T) {
+	_, pubKeys := tempCommittee(t)
+	members, outsider := pubKeys[:3], pubKeys[3]
+	acc, err := bls.AccumulateCommittee(members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	published := &Accumulator{Root: acc.Root, Count: acc.Count}
+
+	proof, err := bls.ProveNonMembership(acc, outsider)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := bls.VerifyNonMembership(published, outsider, proof)
+	if err != nil || !ok {
+		t.Fatalf("expected non-membership proof to verify, ok: %v, err: %v", ok, err)
+	}
+
+	// Members Can Not Get Proof, And Outsider Proof Does Not Transfer To Them.
+	for i, member := range members {
+		if _, err := bls.ProveNonMembership(acc, member); !errors.Is(err, ErrCommitteeMember) {
+			t.Fatalf("member %d: expected ErrCommitteeMember, got %v", i, err)
+		}
+		if ok, _ := bls.VerifyNonMembership(published, member, proof); ok {
+			t.Fatalf("member %d: outsider proof verified for member", i)
+		}
+	}
+
+	// Skipping Over Member With Its Two Neighbours Breaks Adjacency, Claiming Boundary Breaks Count Commitment.
+	for j := range acc.leaves {
+		skipping := &NonMembershipProof{}
+		if j > 0 {
+			skipping.Lower = accumulatorWitness(acc.leaves, j-1)
+		}
+		if j+1 < len(acc.leaves) {
+			skipping.Upper = accumulatorWitness(acc.leaves, j+1)
+		}
+		member := findMember(t, members, acc.leaves[j])
+		if ok, _ := bls.VerifyNonMembership(published, member, skipping); ok {
+			t.Fatalf("member at position %d: skipping proof verified", j)
+		}
+		truncated := &Accumulator{Root: acc.Root, Count: uint64(j)}
+		if j > 0 {
+			if ok, _ := bls.VerifyNonMembership(truncated, member, &NonMembershipProof{Lower: skipping.Lower}); ok {
+				t.Fatalf("member at position %d: truncated count verified", j)
+			}
+		}
+	}
+
+	// Every Gap, Including Both Boundaries, Is Provable.
+	empty, _ := bls.AccumulateCommittee(nil)
+	single, _ := bls.AccumulateCommittee(pubKeys[:1])
+	for _, candidate := range pubKeys[1:] {
+		for _, acc := range []*Accumulator{empty, single} {
+			proof, err := bls.ProveNonMembership(acc, candidate)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok, _ := bls.VerifyNonMembership(acc, candidate, proof); !ok {
+				t.Fatalf("expected proof against %d member accumulator to verify", acc.Count)
+			}
+		}
+	}
+}
+
+func findMember(t *testing.T, members [][3][2]*big.Int, key [32]byte) [3][2]*big.Int {
+	for _, member := range members {
+		if bls.PubKeyIdentifier(member) == key {
+			return member
+		}
+	}
+	t.Fatalf("no member with key %x", key)
+	return [3][2]*big.Int{}
+}
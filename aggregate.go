@@ -0,0 +1,286 @@
+package bn128_bls
+
+// Aggregate Verification APIs, Built On Top Of AggregatePubKeys And AggregateSignatures.
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"runtime"
+)
+
+// Message Count From Which AggregateVerify Hashes Messages In Parallel.
+const parallelHashThreshold = 64
+
+var ErrCountMismatch = errors.New("signature count does not match pubKey count")
+
+// Returns ErrCountMismatch If Number Of Signatures And Number Of PubKeys Differ, Catching Off-By-One Aggregation Bugs Early.
+func AssertConsistentCounts(numSigs, numKeys int) error {
+	if numSigs != numKeys {
+		return fmt.Errorf("%w: %d signatures, %d pubKeys", ErrCountMismatch, numSigs, numKeys)
+	}
+	return nil
+}
+
+// Streams Signatures From `r` And Aggregates Them In Constant Memory, Each Record Is uint16 Length (Must Be 64) || 64 Byte Affine Signature.
+// Every Signature Is Validated As It Is Read, Stream Must End Exactly At Record Boundary.
+func (bls *BLS) AggregateFromReader(r io.Reader) ([3]*big.Int, int, error) {
+	aggSig := bls.zeroG1()
+	count := 0
+	header := make([]byte, 2)
+	record := make([]byte, SignatureSize)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return [3]*big.Int{}, count, fmt.Errorf("failed to read length of signature %d: %v", count, err)
+		}
+		if length := binary.BigEndian.Uint16(header); length != SignatureSize {
+			return [3]*big.Int{}, count, fmt.Errorf("invalid length %d of signature %d, expected %d", length, count, SignatureSize)
+		}
+		if _, err := io.ReadFull(r, record); err != nil {
+			return [3]*big.Int{}, count, fmt.Errorf("failed to read signature %d: %v", count, err)
+		}
+		signature, err := bls.SignatureFromBytes(record)
+		if err != nil {
+			return [3]*big.Int{}, count, fmt.Errorf("signature %d: %w", count, err)
+		}
+		aggSig = bls.addG1(aggSig, signature)
+		count++
+	}
+	if count < 1 {
+		return [3]*big.Int{}, 0, fmt.Errorf("no signature have been read")
+	}
+	return aggSig, count, nil
+}
+
+// Aggregates Individual Signatures Over Same `message` And Their Signers' PubKeys, Then Verifies Aggregate Using VerifyBytes.
+// Every PubKey Is Validated Before Aggregation, So Invalid Keys Cannot Cancel Out In Sum.
+func (bls *BLS) AggregateAndVerify(signatures [][3]*big.Int, pubKeysG2 [][3][2]*big.Int, message []byte) (bool, error) {
+	if err := AssertConsistentCounts(len(signatures), len(pubKeysG2)); err != nil {
+		return false, err
+	}
+	for i, pubKey := range pubKeysG2 {
+		if err := bls.ValidatePubKey(pubKey); err != nil {
+			return false, fmt.Errorf("invalid pubKey at index %d: %w", i, err)
+		}
+	}
+	aggSig, err := bls.AggregateSignatures(signatures)
+	if err != nil {
+		return false, fmt.Errorf("failed to aggregate signatures: %v", err)
+	}
+	aggPubKeyG2, err := bls.aggregatePubKeysG2(pubKeysG2)
+	if err != nil {
+		return false, fmt.Errorf("failed to aggregate pubKeys: %v", err)
+	}
+	return bls.VerifyBytes(aggSig, aggPubKeyG2, message)
+}
+
+// Verifies `aggSig` Over Single `message` Signed By All `pubKeysG2`, Every PubKey Is Validated Before Aggregation.
+// Note: PubKeys Must Come With Proof Of Possession (Or Be Otherwise Trusted), Otherwise Rogue-Key Attacks Are Possible.
+// Empty `pubKeysG2` Returns False, Unless SetAllowEmptyAggregate Is Enabled And `aggSig` Is Identity.
+func (bls *BLS) FastAggregateVerify(aggSig [3]*big.Int, pubKeysG2 [][3][2]*big.Int, message []byte) (bool, error) {
+	if len(pubKeysG2) == 0 {
+		return bls.verifyEmptyAggregate(aggSig), nil
+	}
+	for i, pubKey := range pubKeysG2 {
+		if err := bls.ValidatePubKey(pubKey); err != nil {
+			return false, fmt.Errorf("invalid pubKey at index %d: %w", i, err)
+		}
+	}
+	aggPubKeyG2, err := bls.aggregatePubKeysG2(pubKeysG2)
+	if err != nil {
+		return false, fmt.Errorf("failed to aggregate pubKeys: %v", err)
+	}
+	return bls.VerifyBytes(aggSig, aggPubKeyG2, message)
+}
+
+// Verifies Per-Shard Aggregates Over Same Cross-Link `message`, shardAggSigs[i] Being Signed By shardCommittees[i].
+// Every Shard Aggregate And Committee PubKey Is Weighted By Random Nonzero r_i Before Summing, So Bad Shards Cannot
+// Cancel Each Other Out, And Whole Set Still Costs One Two-Pairing Verification:
+// e(Σ r_i·aggSig_i, G2) == e(H(message), Σ r_i·aggPubKey_i).
+// Failure Does Not Identify Bad Shard, Use FastAggregateVerify Per Shard For That.
+func (bls *BLS) VerifyShardedAggregate(shardAggSigs [][3]*big.Int, shardCommittees [][][3][2]*big.Int, message []byte) (bool, error) {
+	if len(shardAggSigs) != len(shardCommittees) {
+		return false, fmt.Errorf("%d shard aggSigs passed for %d shard committees", len(shardAggSigs), len(shardCommittees))
+	}
+	if len(shardAggSigs) < 1 {
+		return false, fmt.Errorf("no shard have been passed")
+	}
+	combinedSig := bls.zeroG1()
+	combinedPubKeyG2 := bls.bn128.G2.Zero()
+	for shard, committee := range shardCommittees {
+		if err := bls.ValidateSignature(shardAggSigs[shard]); err != nil {
+			return false, fmt.Errorf("invalid aggSig of shard %d: %w", shard, err)
+		}
+		for i, pubKey := range committee {
+			if err := bls.ValidatePubKey(pubKey); err != nil {
+				return false, fmt.Errorf("invalid pubKey at index %d of shard %d: %w", i, shard, err)
+			}
+		}
+		aggPubKeyG2, err := bls.aggregatePubKeysG2(committee)
+		if err != nil {
+			return false, fmt.Errorf("failed to aggregate pubKeys of shard %d: %v", shard, err)
+		}
+		if bls.bn128.G2.IsZero(aggPubKeyG2) {
+			return false, fmt.Errorf("invalid aggregate pubKey of shard %d: %w", shard, ErrPointAtInfinity)
+		}
+		r, err := randomScalar(rand.Reader)
+		if err != nil {
+			return false, err
+		}
+		combinedSig = bls.addG1(combinedSig, bls.bn128.G1.MulScalar(shardAggSigs[shard], r))
+		combinedPubKeyG2 = bls.addG2(combinedPubKeyG2, bls.bn128.G2.MulScalar(aggPubKeyG2, r))
+	}
+	if bls.bn128.G1.IsZero(combinedSig) || bls.bn128.G2.IsZero(combinedPubKeyG2) {
+		return false, fmt.Errorf("invalid combined shard aggregate: %w", ErrPointAtInfinity)
+	}
+	return bls.verifyPoint(combinedSig, combinedPubKeyG2, bls.HashToG1(message)), nil
+}
+
+// Returns `newAggPubKeyG2` − `oldAggPubKeyG2`, Net PubKey Change Between Two Committee Aggregates.
+// Light Clients Can Compare It With Aggregate Of Joined Keys Minus Aggregate Of Left Keys To Update Cached Aggregate Incrementally.
+func (bls *BLS) AggregateDelta(oldAggPubKeyG2, newAggPubKeyG2 [3][2]*big.Int) [3][2]*big.Int {
+	return bls.addG2(newAggPubKeyG2, bls.bn128.G2.Neg(oldAggPubKeyG2))
+}
+
+// Verifies `aggSig` Over Distinct `messages` Where messages[i] Is Signed By pubKeysG2[i]:
+// e(aggSig, G2) == Π e(H(messages[i]), pubKeysG2[i]).
+func (bls *BLS) AggregateVerify(aggSig [3]*big.Int, pubKeysG2 [][3][2]*big.Int, messages [][]byte) (bool, error) {
+	if len(messages) != len(pubKeysG2) {
+		return false, fmt.Errorf("%d messages passed for %d pubKeys", len(messages), len(pubKeysG2))
+	}
+	seen := map[string]bool{}
+	for i, message := range messages {
+		if seen[string(message)] {
+			return false, fmt.Errorf("duplicate message at index %d", i)
+		}
+		seen[string(message)] = true
+	}
+	workers := 1
+	if len(messages) >= parallelHashThreshold {
+		workers = runtime.NumCPU()
+	}
+	messagePoints, err := bls.HashToG1Batch(messages, workers)
+	if err != nil {
+		return false, err
+	}
+	return bls.AggregateVerifyPoints(aggSig, pubKeysG2, messagePoints)
+}
+
+// Point-Based Twin Of AggregateVerify For Callers Doing Their Own Mapping, `messagePoints` Are Used As-Is After Validation.
+func (bls *BLS) AggregateVerifyPoints(aggSig [3]*big.Int, pubKeysG2 [][3][2]*big.Int, messagePoints [][3]*big.Int) (bool, error) {
+	if len(messagePoints) != len(pubKeysG2) {
+		return false, fmt.Errorf("%d messagePoints passed for %d pubKeys", len(messagePoints), len(pubKeysG2))
+	}
+	if len(pubKeysG2) < 1 {
+		return false, fmt.Errorf("zero pubKeysG2 are passed")
+	}
+	if err := bls.ValidateSignature(aggSig); err != nil {
+		return false, fmt.Errorf("invalid aggSig: %w", err)
+	}
+	for i := range pubKeysG2 {
+		if err := bls.ValidatePubKey(pubKeysG2[i]); err != nil {
+			return false, fmt.Errorf("invalid pubKey at index %d: %w", i, err)
+		}
+		// G1 Cofactor Is 1, So On-Curve Check Also Implies Subgroup Membership.
+		if err := bls.ValidateSignature(messagePoints[i]); err != nil {
+			return false, fmt.Errorf("invalid messagePoint at index %d: %w", i, err)
+		}
+	}
+	g1Points := append(append([][3]*big.Int{}, messagePoints...), bls.bn128.G1.Neg(aggSig))
+	g2Points := append(append([][3][2]*big.Int{}, pubKeysG2...), bls.bn128.G2.G)
+	return bls.pairingCheck(g1Points, g2Points), nil
+}
+
+// When Enabled, Aggregate Over Zero Signers Verifies Iff Signature Is Identity, For "Genesis" Or "No Votes" States.
+// Disabled By Default, So Empty Signer Set Never Verifies. Returns ErrConfigLocked Once Instance Has Been Used To Sign Or Verify.
+func (bls *BLS) SetAllowEmptyAggregate(enabled bool) error {
+	if bls.used.Load() {
+		return ErrConfigLocked
+	}
+	bls.allowEmptyAggregate = enabled
+	return nil
+}
+
+func (bls *BLS) verifyEmptyAggregate(aggSig [3]*big.Int) bool {
+	if !bls.allowEmptyAggregate || aggSig[2] == nil {
+		return false
+	}
+	return bls.bn128.Fq1.IsZero(bls.bn128.Fq1.Affine(aggSig[2]))
+}
+
+// Verifies `aggSig` Over `message` Signed By `signerIDs`, Resolving Each Signer's PubKey Lazily Through `resolver`
+// (e.g., Remote Key Store), Resolver Errors Are Wrapped With Failing Signer ID.
+func (bls *BLS) VerifyWithResolver(aggSig [3]*big.Int, signerIDs []uint64, resolver func(uint64) ([3][2]*big.Int, error), message []byte) (bool, error) {
+	if len(signerIDs) < 1 {
+		return false, fmt.Errorf("no signer id have been passed")
+	}
+	if err := bls.ValidateSignature(aggSig); err != nil {
+		return false, fmt.Errorf("invalid aggSig: %w", err)
+	}
+	seen := map[uint64]bool{}
+	pubKeysG2 := make([][3][2]*big.Int, len(signerIDs))
+	for i, signerID := range signerIDs {
+		if seen[signerID] {
+			return false, fmt.Errorf("duplicate signer id %d", signerID)
+		}
+		seen[signerID] = true
+		pubKey, err := resolver(signerID)
+		if err != nil {
+			return false, fmt.Errorf("failed to resolve pubKey of signer %d: %w", signerID, err)
+		}
+		if err := bls.ValidatePubKey(pubKey); err != nil {
+			return false, fmt.Errorf("invalid pubKey of signer %d: %w", signerID, err)
+		}
+		pubKeysG2[i] = pubKey
+	}
+	aggPubKeyG2, err := bls.aggregatePubKeysG2(pubKeysG2)
+	if err != nil {
+		return false, fmt.Errorf("failed to aggregate pubKeys: %v", err)
+	}
+	if bls.bn128.G2.IsZero(aggPubKeyG2) {
+		return false, fmt.Errorf("invalid aggregate pubKey: %w", ErrPointAtInfinity)
+	}
+	return bls.verifyPoint(aggSig, aggPubKeyG2, bls.HashToG1(message)), nil
+}
+
+// Verifies Every signatures[i] Over `message` Under pubKeysG2[i] And Aggregates Only Valid Ones,
+// Returning Aggregate Together With Indices Of Included Signatures, So Noisy Input Still Yields Valid Aggregate.
+// Repeated Signer Is Included Only Once, At Its First Valid Index. Identity Aggregate (e.g., Signers pk And −pk) Is Rejected.
+// Performs 2 Pairings Per Signature.
+func (bls *BLS) BuildMaximalAggregate(signatures [][3]*big.Int, pubKeysG2 [][3][2]*big.Int, message []byte) ([3]*big.Int, []int, error) {
+	if err := AssertConsistentCounts(len(signatures), len(pubKeysG2)); err != nil {
+		return [3]*big.Int{}, nil, err
+	}
+	messageG1 := bls.HashToG1(message)
+	aggSig := bls.zeroG1()
+	includedIndices := []int{}
+	included := map[[32]byte]bool{}
+	for i := range signatures {
+		if bls.ValidateSignature(signatures[i]) != nil || bls.ValidatePubKey(pubKeysG2[i]) != nil {
+			continue
+		}
+		signerID := bls.PubKeyIdentifier(pubKeysG2[i])
+		if included[signerID] {
+			continue
+		}
+		if !bls.verifyPoint(signatures[i], pubKeysG2[i], messageG1) {
+			continue
+		}
+		aggSig = bls.addG1(aggSig, signatures[i])
+		includedIndices = append(includedIndices, i)
+		included[signerID] = true
+	}
+	if len(includedIndices) < 1 {
+		return [3]*big.Int{}, nil, fmt.Errorf("no valid signature have been passed")
+	}
+	if bls.bn128.G1.IsZero(aggSig) {
+		return [3]*big.Int{}, nil, fmt.Errorf("invalid aggregate of signatures %v: %w", includedIndices, ErrPointAtInfinity)
+	}
+	return aggSig, includedIndices, nil
+}
@@ -0,0 +1,371 @@
+package bn128_bls
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	mathrand "math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func signAll(t testing.TB, keyPairs []*KeyPair, message []byte) [][3]*big.Int {
+	signatures := [][3]*big.Int{}
+	for _, keyPair := range keyPairs {
+		signature, err := bls.SignBytes(keyPair, message)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signatures = append(signatures, signature)
+	}
+	return signatures
+}
+
+func TestAggregateAndVerify(t *testing.T) {
+	if err := AssertConsistentCounts(3, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := AssertConsistentCounts(3, 4); !errors.Is(err, ErrCountMismatch) {
+		t.Fatalf("expected ErrCountMismatch, got: %v", err)
+	}
+
+	keyPairs, pubKeys := tempCommittee(t)
+	signatures := signAll(t, keyPairs, tempMessage)
+	if _, err := bls.AggregateAndVerify(signatures[:3], pubKeys, tempMessage); !errors.Is(err, ErrCountMismatch) {
+		t.Fatalf("expected ErrCountMismatch, got: %v", err)
+	}
+
+	ok, err := bls.AggregateAndVerify(signatures, pubKeys, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected aggregate to verify, ok: %v, err: %v", ok, err)
+	}
+
+	offSubgroupPubKeys := append([][3][2]*big.Int{}, pubKeys...)
+	offSubgroupPubKeys[1] = randomTwistPoint(t)
+	_, err = bls.AggregateAndVerify(signatures, offSubgroupPubKeys, tempMessage)
+	if !errors.Is(err, ErrNotInSubgroup) || !strings.Contains(err.Error(), "index 1") {
+		t.Fatalf("expected ErrNotInSubgroup at index 1, got: %v", err)
+	}
+}
+
+func TestAggregateDelta(t *testing.T) {
+	_, pubKeys := tempCommittee(t)
+	oldAggPubKeyG2, err := bls.aggregatePubKeysG2(pubKeys[:3])
+	if err != nil {
+		t.Fatal(err)
+	}
+	newAggPubKeyG2, err := bls.aggregatePubKeysG2(pubKeys[1:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	delta := bls.AggregateDelta(oldAggPubKeyG2, newAggPubKeyG2)
+	if !bls.bn128.G2.Equal(bls.bn128.G2.Add(oldAggPubKeyG2, delta), newAggPubKeyG2) {
+		t.Fatal("expected old aggregate plus delta to equal new aggregate")
+	}
+
+	// Committee Change Dropped pubKeys[0] And Added Remaining Keys Beyond Index 2.
+	expected := bls.bn128.G2.Neg(pubKeys[0])
+	for _, pubKey := range pubKeys[3:] {
+		expected = bls.bn128.G2.Add(expected, pubKey)
+	}
+	if !bls.bn128.G2.Equal(delta, expected) {
+		t.Fatal("expected delta to match joined keys minus left keys")
+	}
+
+	// Whole Committee Replaced By Its Negation, Delta Is 2·newAgg Rather Than Identity.
+	negatedAggPubKeyG2 := bls.bn128.G2.Neg(oldAggPubKeyG2)
+	delta = bls.AggregateDelta(oldAggPubKeyG2, negatedAggPubKeyG2)
+	if !bls.bn128.G2.Equal(delta, bls.bn128.G2.Double(negatedAggPubKeyG2)) {
+		t.Fatal("expected delta to be double of new aggregate when new aggregate is negated old one")
+	}
+}
+
+func TestAggregateVerifyPoints(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	keyPairs, pubKeys = keyPairs[:2], pubKeys[:2]
+	messages := tempMessages[:2]
+	signatures := [][3]*big.Int{}
+	messagePoints := [][3]*big.Int{}
+	for i, keyPair := range keyPairs {
+		signature, _ := bls.SignBytes(keyPair, messages[i])
+		signatures = append(signatures, signature)
+		messagePoints = append(messagePoints, bls.HashToG1(messages[i]))
+	}
+	aggSig, _ := bls.AggregateSignatures(signatures)
+
+	ok, err := bls.AggregateVerify(aggSig, pubKeys, messages)
+	if err != nil || !ok {
+		t.Fatalf("expected aggregate to verify, ok: %v, err: %v", ok, err)
+	}
+	ok, err = bls.AggregateVerifyPoints(aggSig, pubKeys, messagePoints)
+	if err != nil || !ok {
+		t.Fatalf("expected aggregate over points to verify, ok: %v, err: %v", ok, err)
+	}
+	ok, err = bls.AggregateVerifyPoints(aggSig, pubKeys, [][3]*big.Int{messagePoints[1], messagePoints[0]})
+	if err != nil || ok {
+		t.Fatalf("expected swapped points to fail, ok: %v, err: %v", ok, err)
+	}
+
+	if _, err := bls.AggregateVerify(aggSig, pubKeys, [][]byte{messages[0], messages[0]}); err == nil {
+		t.Fatal("expected error for duplicate messages")
+	}
+	offCurve := [3]*big.Int{big.NewInt(1), big.NewInt(3), big.NewInt(1)}
+	if _, err := bls.AggregateVerifyPoints(aggSig, pubKeys, [][3]*big.Int{messagePoints[0], offCurve}); !errors.Is(err, ErrNotOnCurve) {
+		t.Fatalf("expected ErrNotOnCurve, got: %v", err)
+	}
+}
+
+func TestEmptyAggregate(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+
+	ok, err := bls.FastAggregateVerify(bls.zeroG1(), nil, tempMessage)
+	if err != nil || ok {
+		t.Fatalf("expected empty aggregate to fail by default, ok: %v, err: %v", ok, err)
+	}
+
+	emptyBls := NewBls()
+	if err := emptyBls.SetAllowEmptyAggregate(true); err != nil {
+		t.Fatal(err)
+	}
+	ok, err = emptyBls.FastAggregateVerify(emptyBls.zeroG1(), [][3][2]*big.Int{}, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected identity over empty set to verify, ok: %v, err: %v", ok, err)
+	}
+	ok, err = emptyBls.FastAggregateVerify(signature, nil, tempMessage)
+	if err != nil || ok {
+		t.Fatalf("expected non-identity over empty set to fail, ok: %v, err: %v", ok, err)
+	}
+	ok, err = emptyBls.FastAggregateVerifyMarked(emptyBls.zeroG1(), nil, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected marked variant to follow same policy, ok: %v, err: %v", ok, err)
+	}
+}
+
+func TestAggregateFromReader(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signatures := [][3]*big.Int{}
+	stream := []byte{}
+	for i := 0; i < 20; i++ {
+		signature, _ := bls.SignBytes(keyPair, []byte(fmt.Sprintf("streamed message %d", i)))
+		signatures = append(signatures, signature)
+		stream = binary.BigEndian.AppendUint16(stream, SignatureSize)
+		stream = append(stream, bls.SignatureToBytes(signature)...)
+	}
+
+	aggSig, count, err := bls.AggregateFromReader(bytes.NewReader(stream))
+	if err != nil || count != len(signatures) {
+		t.Fatalf("expected %d signatures, got %d, err: %v", len(signatures), count, err)
+	}
+	expected, _ := bls.AggregateSignatures(signatures)
+	if !bls.bn128.G1.Equal(aggSig, expected) {
+		t.Fatal("streamed aggregate does not match AggregateSignatures")
+	}
+
+	// Repeated Record Must Be Doubled, Not Cancelled.
+	repeated := append(append([]byte{}, stream[:2+SignatureSize]...), stream[:2+SignatureSize]...)
+	aggSig, count, err = bls.AggregateFromReader(bytes.NewReader(repeated))
+	if err != nil || count != 2 {
+		t.Fatalf("expected 2 signatures, got %d, err: %v", count, err)
+	}
+	if !bls.bn128.G1.Equal(aggSig, bls.bn128.G1.MulScalar(signatures[0], big.NewInt(2))) {
+		t.Fatal("streamed aggregate of repeated signature does not match doubled signature")
+	}
+
+	if _, _, err := bls.AggregateFromReader(bytes.NewReader(stream[:len(stream)-1])); err == nil {
+		t.Fatal("expected error for truncated stream")
+	}
+	if _, _, err := bls.AggregateFromReader(bytes.NewReader(nil)); err == nil {
+		t.Fatal("expected error for empty stream")
+	}
+	corrupt := append([]byte{}, stream...)
+	corrupt[2+coordinateSize-1] ^= 1
+	if _, _, err := bls.AggregateFromReader(bytes.NewReader(corrupt)); !errors.Is(err, ErrNotOnCurve) {
+		t.Fatalf("expected ErrNotOnCurve, got: %v", err)
+	}
+}
+
+func TestVerifyWithResolver(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	keyStore := map[uint64][3][2]*big.Int{}
+	for i, pubKey := range pubKeys {
+		keyStore[uint64(100+i)] = pubKey
+	}
+	errKeyNotFound := errors.New("key not found")
+	resolver := func(signerID uint64) ([3][2]*big.Int, error) {
+		pubKey, ok := keyStore[signerID]
+		if !ok {
+			return [3][2]*big.Int{}, errKeyNotFound
+		}
+		return pubKey, nil
+	}
+	aggSig, _ := bls.AggregateSignatures(signAll(t, []*KeyPair{keyPairs[0], keyPairs[2]}, tempMessage))
+
+	ok, err := bls.VerifyWithResolver(aggSig, []uint64{100, 102}, resolver, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected aggregate to verify, ok: %v, err: %v", ok, err)
+	}
+	if _, err := bls.VerifyWithResolver(aggSig, []uint64{100, 999}, resolver, tempMessage); !errors.Is(err, errKeyNotFound) {
+		t.Fatalf("expected resolver error to be propagated, got: %v", err)
+	}
+	if _, err := bls.VerifyWithResolver(aggSig, []uint64{100, 100}, resolver, tempMessage); err == nil {
+		t.Fatal("expected error for duplicate signer id")
+	}
+}
+
+func TestBuildMaximalAggregate(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	signatures := signAll(t, keyPairs, tempMessage)
+	signatures[1], _ = bls.SignBytes(keyPairs[1], []byte("other message"))
+	signatures[3] = [3]*big.Int{big.NewInt(1), big.NewInt(3), big.NewInt(1)}
+
+	aggSig, includedIndices, err := bls.BuildMaximalAggregate(signatures, pubKeys, tempMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(includedIndices, []int{0, 2}) {
+		t.Fatalf("expected indices [0 2], got %v", includedIndices)
+	}
+	expected, _ := bls.AggregateSignatures([][3]*big.Int{signatures[0], signatures[2]})
+	if !bls.bn128.G1.Equal(aggSig, expected) {
+		t.Fatal("expected aggregate of valid signatures only")
+	}
+
+	if _, _, err := bls.BuildMaximalAggregate(signatures[3:], pubKeys[3:], tempMessage); err == nil {
+		t.Fatal("expected error when no signature is valid")
+	}
+
+	// Same Signer Listed Twice Is Included Once Instead Of Doubling To Infinity.
+	aggSig, includedIndices, err = bls.BuildMaximalAggregate(append(signatures[:1:1], signatures[0]), append(pubKeys[:1:1], pubKeys[0]), tempMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(includedIndices, []int{0}) || !bls.bn128.G1.Equal(aggSig, signatures[0]) {
+		t.Fatalf("expected duplicate signer to be included once, got indices %v", includedIndices)
+	}
+
+	// Signatures Of pk And −pk Are Both Valid But Sum To Infinity.
+	negSignature := bls.bn128.G1.Neg(signatures[0])
+	negPubKey := bls.bn128.G2.Neg(pubKeys[0])
+	if _, _, err := bls.BuildMaximalAggregate([][3]*big.Int{signatures[0], negSignature}, [][3][2]*big.Int{pubKeys[0], negPubKey}, tempMessage); !errors.Is(err, ErrPointAtInfinity) {
+		t.Fatalf("expected ErrPointAtInfinity, got: %v", err)
+	}
+}
+
+// Fuzzes Aggregation Identity: AggregateVerify(Σsig) Holds Exactly When Every Component Verifies.
+// At Most One Component Is Tampered With Per Iteration, Since Compensating Tamperings Across Components
+// (sig0 + D, sig1 - D) Cancel In Sum And Are Inherently Invisible To Aggregate Verification.
+// BLS Signatures Are Unique, So Component Verifies Exactly When It Equals sk·H(m); Checking That Instead Of
+// Calling VerifyBytes Saves One Final Exponentiation Per Component, Which Keeps Hundreds Of Iterations Affordable.
+func TestAggregateHomomorphism(t *testing.T) {
+	iterations := 300
+	if testing.Short() {
+		iterations = 2
+	}
+	seed := time.Now().UnixNano()
+	t.Logf("seed %d", seed)
+	rng := mathrand.New(mathrand.NewSource(seed))
+
+	randomKeyPair := func() *KeyPair {
+		scalar, err := RandomScalar(rng)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyPair, err := bls.NewKeyPair(scalar.Text(16))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return keyPair
+	}
+
+	for iteration := 0; iteration < iterations; iteration++ {
+		n := 1 + rng.Intn(3)
+		keyPairs := make([]*KeyPair, n)
+		pubKeys := make([][3][2]*big.Int, n)
+		messages := make([][]byte, n)
+		signatures := make([][3]*big.Int, n)
+		for i := 0; i < n; i++ {
+			keyPair := randomKeyPair()
+			keyPairs[i] = keyPair
+			pubKeys[i] = keyPair.PubKey
+			// Random Messages Of Length 1 To 8, Index Suffix Keeps Them Distinct.
+			messages[i] = make([]byte, rng.Intn(8))
+			rng.Read(messages[i])
+			messages[i] = append(messages[i], byte(i))
+			signatures[i], _ = bls.SignBytes(keyPair, messages[i])
+		}
+
+		target := rng.Intn(n)
+		tampering := rng.Intn(5)
+		switch tampering {
+		case 1:
+			scalar, _ := RandomScalar(rng)
+			signatures[target] = bls.bn128.G1.Add(signatures[target], bls.mulBaseG1(scalar))
+		case 2:
+			scalar, _ := RandomScalar(rng)
+			signatures[target] = bls.bn128.G1.MulScalar(signatures[target], scalar)
+		case 3:
+			signatures[target], _ = bls.SignBytes(randomKeyPair(), messages[target])
+		case 4:
+			signatures[target], _ = bls.SignBytes(randomKeyPair(), messages[(target+1)%n])
+		}
+
+		allValid := true
+		for i := 0; i < n; i++ {
+			expected := bls.bn128.G1.MulScalar(bls.HashToG1(messages[i]), keyPairs[i].PrivateKey)
+			allValid = allValid && bls.bn128.G1.Equal(signatures[i], expected)
+		}
+		aggSig, err := bls.AggregateSignatures(signatures)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ok, err := bls.AggregateVerify(aggSig, pubKeys, messages)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != allValid {
+			t.Fatalf("iteration %d (n %d, tampering %d at %d): aggregate %v, components %v", iteration, n, tampering, target, ok, allValid)
+		}
+		if allValid != (tampering == 0) {
+			t.Fatalf("iteration %d: tampering %d left components valid %v", iteration, tampering, allValid)
+		}
+	}
+}
+
+func TestVerifyShardedAggregate(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	shardCommittees := [][][3][2]*big.Int{pubKeys[:2], pubKeys[2:]}
+	shard0, _ := bls.AggregateSignatures(signAll(t, keyPairs[:2], tempMessage))
+	shard1, _ := bls.AggregateSignatures(signAll(t, keyPairs[2:], tempMessage))
+
+	ok, err := bls.VerifyShardedAggregate([][3]*big.Int{shard0, shard1}, shardCommittees, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected sharded aggregate to verify, ok: %v, err: %v", ok, err)
+	}
+
+	// Offsets Cancel In Unweighted Sum Of Shard Aggregates.
+	delta := bls.HashToG1(tempMessages[0])
+	ok, err = bls.VerifyShardedAggregate([][3]*big.Int{bls.bn128.G1.Add(shard0, delta), bls.bn128.G1.Sub(shard1, delta)}, shardCommittees, tempMessage)
+	if err != nil || ok {
+		t.Fatalf("expected cancelling bad shard aggregates to fail, ok: %v, err: %v", ok, err)
+	}
+
+	// Duplicate Shard Must Not Collapse To Infinity And Verify Message Nobody Signed.
+	ok, _ = bls.VerifyShardedAggregate([][3]*big.Int{shard0, shard0}, [][][3][2]*big.Int{shardCommittees[0], shardCommittees[0]}, tempMessages[0])
+	if ok {
+		t.Fatal("expected duplicate shards over unsigned message to fail")
+	}
+	ok, err = bls.VerifyShardedAggregate([][3]*big.Int{shard0, shard0}, [][][3][2]*big.Int{shardCommittees[0], shardCommittees[0]}, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected duplicate valid shards to verify, ok: %v, err: %v", ok, err)
+	}
+
+	if _, err := bls.VerifyShardedAggregate([][3]*big.Int{shard0}, shardCommittees, tempMessage); err == nil {
+		t.Fatal("expected error for shard count mismatch")
+	}
+}
@@ -0,0 +1,33 @@
+package bn128_bls
+
+import (
+	"math/big"
+	"time"
+)
+
+// Verification Details Meant To Be Logged, So Every Integrator Records Same Facts About Each Verification.
+type VerifyResult struct {
+	Valid bool
+	// Keccak256 Of Raw Message, Without DST.
+	MessageHash [32]byte
+	// PubKeyIdentifier Of Signer, Zero If PubKey Was Rejected By Validation.
+	PubKeyID       [32]byte
+	DSTFingerprint [32]byte
+	// Taken From Same Clock As VerifyWithExpiry, With Second Precision.
+	VerifiedAt time.Time
+}
+
+// Same As VerifyBytes But Also Returns VerifyResult, Which Is Filled Even When Inputs Are Rejected.
+func (bls *BLS) VerifyAudit(signature [3]*big.Int, signerPubKey [3][2]*big.Int, message []byte) (VerifyResult, error) {
+	result := VerifyResult{
+		MessageHash:    keccak256(message),
+		DSTFingerprint: bls.DSTFingerprint(),
+		VerifiedAt:     bls.now().Truncate(time.Second),
+	}
+	if bls.ValidatePubKey(signerPubKey) == nil {
+		result.PubKeyID = bls.PubKeyIdentifier(signerPubKey)
+	}
+	valid, err := bls.VerifyBytes(signature, signerPubKey, message)
+	result.Valid = valid
+	return result, err
+}
@@ -0,0 +1,44 @@
+package bn128_bls
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/sha3"
+)
+
+func TestVerifyAudit(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+
+	clockBls := NewBls()
+	now := time.Unix(1700000000, 500)
+	clockBls.clock = func() time.Time { return now }
+	result, err := clockBls.VerifyAudit(signature, keyPair.PubKey, tempMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Valid {
+		t.Fatal("expected valid result")
+	}
+
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(tempMessage)
+	if !bytes.Equal(result.MessageHash[:], hasher.Sum(nil)) {
+		t.Fatal("unexpected message hash")
+	}
+	hasher.Reset()
+	hasher.Write(bls.PubKeyToBytes(keyPair.PubKey))
+	if !bytes.Equal(result.PubKeyID[:], hasher.Sum(nil)) {
+		t.Fatal("unexpected pubKey identifier")
+	}
+	hasher.Reset()
+	hasher.Write([]byte(DefaultDST))
+	if !bytes.Equal(result.DSTFingerprint[:], hasher.Sum(nil)) {
+		t.Fatal("unexpected dst fingerprint")
+	}
+	if !result.VerifiedAt.Equal(time.Unix(1700000000, 0)) {
+		t.Fatal("unexpected verification time")
+	}
+}
@@ -0,0 +1,181 @@
+package bn128_bls
+
+// Batch Verification Using Random Linear Combination, Each Relation Is Scaled By Random Scalar r_i So Invalid Signatures
+// Cannot Cancel Each Other Out, Except With Negligible Probability.
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+const batchCoefficientsDST = "BN128_BLS_BATCH_COEFFICIENTS_"
+
+// One Signature Relation Of Batch.
+type VerifyTriple struct {
+	Signature [3]*big.Int
+	PubKey    [3][2]*big.Int
+	Message   []byte
+}
+
+// When Enabled BatchVerify Derives Its Coefficients Using DeriveBatchCoefficients (Fiat-Shamir) Instead Of crypto/rand,
+// Making Batch Verification Deterministic, Useful When Verifier Has No Trusted Randomness.
+// Returns ErrConfigLocked Once Instance Has Been Used To Sign Or Verify.
+func (bls *BLS) SetDeterministicBatchCoefficients(enabled bool) error {
+	if bls.used.Load() {
+		return ErrConfigLocked
+	}
+	bls.deterministicBatchCoefficients = enabled
+	return nil
+}
+
+// Derives One Coefficient In [1, R-1] Per Input From Keccak256 Stream Keyed By Whole Length-Prefixed Input List,
+// So Changing Any Input Changes Every Coefficient.
+func DeriveBatchCoefficients(inputs ...[]byte) []*big.Int {
+	transcript := []byte(batchCoefficientsDST)
+	transcript = binary.BigEndian.AppendUint32(transcript, uint32(len(inputs)))
+	for _, input := range inputs {
+		transcript = binary.BigEndian.AppendUint32(transcript, uint32(len(input)))
+		transcript = append(transcript, input...)
+	}
+	stream := &keccakStream{seed: keccak256(transcript)}
+	coefficients := make([]*big.Int, len(inputs))
+	for i := range coefficients {
+		// Keccak Stream Never Fails And Returns Zero Scalar With Negligible Probability.
+		coefficients[i], _ = randomScalar(stream)
+	}
+	return coefficients
+}
+
+// Verifies Signatures Of Different Signers Over Different Messages Using len(triples)+1 Pairings:
+// e(Σ r_i·sig_i, G2) == Π e(r_i·H(m_i), pubKey_i).
+func (bls *BLS) BatchVerify(triples []VerifyTriple) (bool, error) {
+	return bls.BatchVerifyContext(context.Background(), triples)
+}
+
+// Same As BatchVerify But Checks `ctx` Before Every Per-Triple Step, Returning ctx.Err() Once It Is Done.
+// All Intermediate Values Are Local, So Canceled Call Leaves Instance Untouched.
+func (bls *BLS) BatchVerifyContext(ctx context.Context, triples []VerifyTriple) (bool, error) {
+	return bls.batchVerifyContext(ctx, triples, bls.HashToG1)
+}
+
+// Same As BatchVerifyContext With Messages Mapped To G1 Using `hashToG1`.
+func (bls *BLS) batchVerifyContext(ctx context.Context, triples []VerifyTriple, hashToG1 func([]byte) [3]*big.Int) (bool, error) {
+	if len(triples) < 1 {
+		return false, fmt.Errorf("no triple have been passed")
+	}
+	for i, triple := range triples {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		if err := bls.ValidateSignature(triple.Signature); err != nil {
+			return false, fmt.Errorf("invalid signature at index %d: %w", i, err)
+		}
+		if err := bls.ValidatePubKey(triple.PubKey); err != nil {
+			return false, fmt.Errorf("invalid pubKey at index %d: %w", i, err)
+		}
+	}
+	coefficients, err := bls.batchCoefficients(triples)
+	if err != nil {
+		return false, err
+	}
+
+	combinedSignature := bls.zeroG1()
+	g1Points := [][3]*big.Int{}
+	g2Points := [][3][2]*big.Int{}
+	for i, triple := range triples {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		combinedSignature = bls.bn128.G1.Add(combinedSignature, bls.bn128.G1.MulScalar(triple.Signature, coefficients[i]))
+		g1Points = append(g1Points, bls.bn128.G1.MulScalar(hashToG1(triple.Message), coefficients[i]))
+		g2Points = append(g2Points, triple.PubKey)
+	}
+	g1Points = append(g1Points, bls.bn128.G1.Neg(combinedSignature))
+	g2Points = append(g2Points, bls.bn128.G2.G)
+	return bls.pairingCheckContext(ctx, g1Points, g2Points)
+}
+
+// Verifies One Aggregate Signature Per Block (e.g., During Light Client Sync) With Single Random Linear Combination,
+// Block i Is Valid If aggSigs[i] Verifies Over messages[i] Under aggPubKeysG2[i].
+func (bls *BLS) BatchVerifyAggregates(aggSigs [][3]*big.Int, aggPubKeysG2 [][3][2]*big.Int, messages [][]byte) (bool, error) {
+	if len(aggSigs) != len(aggPubKeysG2) || len(aggSigs) != len(messages) {
+		return false, fmt.Errorf("length mismatch: %d aggSigs, %d aggPubKeys, %d messages", len(aggSigs), len(aggPubKeysG2), len(messages))
+	}
+	triples := make([]VerifyTriple, len(aggSigs))
+	for i := range aggSigs {
+		triples[i] = VerifyTriple{Signature: aggSigs[i], PubKey: aggPubKeysG2[i], Message: messages[i]}
+	}
+	return bls.BatchVerify(triples)
+}
+
+func (bls *BLS) batchCoefficients(triples []VerifyTriple) ([]*big.Int, error) {
+	if bls.deterministicBatchCoefficients {
+		inputs := make([][]byte, len(triples))
+		for i, triple := range triples {
+			input := append(bls.SignatureToBytes(triple.Signature), bls.PubKeyToBytes(triple.PubKey)...)
+			inputs[i] = append(input, triple.Message...)
+		}
+		return DeriveBatchCoefficients(inputs...), nil
+	}
+	coefficients := make([]*big.Int, len(triples))
+	for i := range coefficients {
+		r, err := randomScalar(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		coefficients[i] = r
+	}
+	return coefficients, nil
+}
+
+// Verifies Many Signatures Of One Signer Over Different Messages Using Two Pairings In Total:
+// e(Σ r_i·H(m_i), pubKey) == e(Σ r_i·sig_i, G2).
+func (bls *BLS) VerifyBatchSameKey(signatures [][3]*big.Int, pubKey [3][2]*big.Int, messages [][]byte) (bool, error) {
+	if len(signatures) != len(messages) {
+		return false, fmt.Errorf("signatures and messages must be same length, got %d and %d", len(signatures), len(messages))
+	}
+	if len(signatures) < 1 {
+		return false, fmt.Errorf("no signature have been passed")
+	}
+	if err := bls.ValidatePubKey(pubKey); err != nil {
+		return false, fmt.Errorf("invalid pubKey: %w", err)
+	}
+
+	combinedMessage := bls.zeroG1()
+	combinedSignature := bls.zeroG1()
+	for i, signature := range signatures {
+		if err := bls.ValidateSignature(signature); err != nil {
+			return false, fmt.Errorf("invalid signature at index %d: %w", i, err)
+		}
+		r, err := randomScalar(rand.Reader)
+		if err != nil {
+			return false, err
+		}
+		combinedMessage = bls.bn128.G1.Add(combinedMessage, bls.bn128.G1.MulScalar(bls.HashToG1(messages[i]), r))
+		combinedSignature = bls.bn128.G1.Add(combinedSignature, bls.bn128.G1.MulScalar(signature, r))
+	}
+	return bls.verifyPoint(combinedSignature, pubKey, combinedMessage), nil
+}
+
+// Deterministic Byte Stream Keccak256(seed || counter), Used To Feed randomScalar.
+type keccakStream struct {
+	seed    [32]byte
+	counter uint64
+	buf     []byte
+}
+
+func (s *keccakStream) Read(p []byte) (int, error) {
+	for i := range p {
+		if len(s.buf) == 0 {
+			block := keccak256(s.seed[:], binary.BigEndian.AppendUint64(nil, s.counter))
+			s.counter++
+			s.buf = block[:]
+		}
+		p[i] = s.buf[0]
+		s.buf = s.buf[1:]
+	}
+	return len(p), nil
+}
@@ -0,0 +1,172 @@
+package bn128_bls
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+)
+
+var tempMessages = [][]byte{
+	[]byte("bn128_bls batch message 0"),
+	[]byte("bn128_bls batch message 1"),
+	[]byte("bn128_bls batch message 2"),
+}
+
+func TestVerifyBatchSameKey(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signatures := [][3]*big.Int{}
+	for _, message := range tempMessages {
+		signature, _ := bls.SignBytes(keyPair, message)
+		signatures = append(signatures, signature)
+	}
+
+	ok, err := bls.VerifyBatchSameKey(signatures, keyPair.PubKey, tempMessages)
+	if err != nil || !ok {
+		t.Fatalf("expected batch to verify, ok: %v, err: %v", ok, err)
+	}
+
+	wrongSignatures := append([][3]*big.Int{}, signatures...)
+	wrongSignatures[1], _ = bls.SignBytes(keyPair, []byte("not in batch"))
+	ok, err = bls.VerifyBatchSameKey(wrongSignatures, keyPair.PubKey, tempMessages)
+	if err != nil || ok {
+		t.Fatalf("expected batch with wrong signature to fail, ok: %v, err: %v", ok, err)
+	}
+
+	if _, err := bls.VerifyBatchSameKey(signatures[:2], keyPair.PubKey, tempMessages); err == nil {
+		t.Fatal("expected error for length mismatch")
+	}
+}
+
+func TestDeriveBatchCoefficients(t *testing.T) {
+	coefficients := DeriveBatchCoefficients(tempMessages...)
+	if len(coefficients) != len(tempMessages) {
+		t.Fatalf("expected %d coefficients, got %d", len(tempMessages), len(coefficients))
+	}
+	again := DeriveBatchCoefficients(tempMessages...)
+	for i := range coefficients {
+		if coefficients[i].Sign() <= 0 || coefficients[i].Cmp(curveOrder) >= 0 {
+			t.Fatalf("coefficient %d out of range", i)
+		}
+		if coefficients[i].Cmp(again[i]) != 0 {
+			t.Fatalf("coefficient %d is not deterministic", i)
+		}
+	}
+
+	for i := range tempMessages {
+		altered := append([][]byte{}, tempMessages...)
+		altered[i] = append(append([]byte{}, tempMessages[i]...), 0)
+		alteredCoefficients := DeriveBatchCoefficients(altered...)
+		for j := range coefficients {
+			if coefficients[j].Cmp(alteredCoefficients[j]) == 0 {
+				t.Fatalf("altering input %d did not change coefficient %d", i, j)
+			}
+		}
+	}
+
+	// Moving Bytes Across Input Boundary Must Not Produce Same Transcript.
+	split := DeriveBatchCoefficients([]byte("ab"), []byte("c"))
+	moved := DeriveBatchCoefficients([]byte("a"), []byte("bc"))
+	if split[0].Cmp(moved[0]) == 0 {
+		t.Fatal("expected input framing to be unambiguous")
+	}
+}
+
+func TestBatchVerify(t *testing.T) {
+	keyPairs := []*KeyPair{}
+	for _, privateKey := range tempPrivateKeys[:2] {
+		keyPair, _ := bls.NewKeyPair(privateKey)
+		keyPairs = append(keyPairs, keyPair)
+	}
+	triples := []VerifyTriple{}
+	for i, keyPair := range keyPairs {
+		signature, _ := bls.SignBytes(keyPair, tempMessages[i])
+		triples = append(triples, VerifyTriple{Signature: signature, PubKey: keyPair.PubKey, Message: tempMessages[i]})
+	}
+
+	deterministicBls := NewBls()
+	if err := deterministicBls.SetDeterministicBatchCoefficients(true); err != nil {
+		t.Fatal(err)
+	}
+	ok, err := deterministicBls.BatchVerify(triples)
+	if err != nil || !ok {
+		t.Fatalf("expected batch to verify, ok: %v, err: %v", ok, err)
+	}
+
+	swapped := append([]VerifyTriple{}, triples...)
+	swapped[0].Message, swapped[1].Message = swapped[1].Message, swapped[0].Message
+	ok, err = bls.BatchVerify(swapped)
+	if err != nil || ok {
+		t.Fatalf("expected batch with swapped messages to fail, ok: %v, err: %v", ok, err)
+	}
+
+	if _, err := bls.BatchVerify(nil); err == nil {
+		t.Fatal("expected error for empty batch")
+	}
+}
+
+func TestBatchVerifyAggregates(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	aggSigs := [][3]*big.Int{}
+	aggPubKeys := [][3][2]*big.Int{}
+	for i, message := range tempMessages {
+		// Block i Is Signed By Committee Members i And i+1.
+		signers := keyPairs[i : i+2]
+		aggSig, _ := bls.AggregateSignatures(signAll(t, signers, message))
+		aggPubKey, _ := bls.aggregatePubKeysG2(pubKeys[i : i+2])
+		aggSigs = append(aggSigs, aggSig)
+		aggPubKeys = append(aggPubKeys, aggPubKey)
+	}
+
+	ok, err := bls.BatchVerifyAggregates(aggSigs, aggPubKeys, tempMessages)
+	if err != nil || !ok {
+		t.Fatalf("expected all blocks to verify, ok: %v, err: %v", ok, err)
+	}
+
+	badAggPubKeys := append([][3][2]*big.Int{}, aggPubKeys...)
+	badAggPubKeys[1] = pubKeys[1]
+	ok, err = bls.BatchVerifyAggregates(aggSigs, badAggPubKeys, tempMessages)
+	if err != nil || ok {
+		t.Fatalf("expected batch with one bad block to fail, ok: %v, err: %v", ok, err)
+	}
+
+	if _, err := bls.BatchVerifyAggregates(aggSigs, aggPubKeys[:2], tempMessages); err == nil {
+		t.Fatal("expected error for length mismatch")
+	}
+}
+
+func TestBatchVerifyContext(t *testing.T) {
+	keyPairs, _ := tempCommittee(t)
+	triples := []VerifyTriple{}
+	for i, keyPair := range keyPairs {
+		message := []byte(fmt.Sprintf("bn128_bls context message %d", i))
+		signature, _ := bls.SignBytes(keyPair, message)
+		triples = append(triples, VerifyTriple{Signature: signature, PubKey: keyPair.PubKey, Message: message})
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := bls.BatchVerifyContext(canceled, triples); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	// Deadline Expires Mid-Batch, Call Must Return Within About One Per-Triple Step Of It.
+	const deadline = 50 * time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), deadline)
+	defer cancel()
+	start := time.Now()
+	if _, err := bls.BatchVerifyContext(ctx, triples); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > deadline+time.Second {
+		t.Fatalf("canceled batch returned after %v", elapsed)
+	}
+
+	// Shared Instance Still Verifies After Cancellation.
+	ok, err := bls.BatchVerifyContext(context.Background(), triples[:2])
+	if err != nil || !ok {
+		t.Fatalf("expected batch to verify after cancellation, ok: %v, err: %v", ok, err)
+	}
+}
@@ -0,0 +1,145 @@
+package bn128_bls
+
+// Signer Bitmaps Select Subset Of Ordered PubKey List, Bit i Is (bitmap[i/8] >> (i%8)) & 1, So Signer 0 Is Lowest Bit Of First Byte.
+// Bitmap For n Signers Must Be Exactly (n+7)/8 Bytes Long With Unused High Bits Of Last Byte Cleared.
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+var ErrQuorumNotMet = errors.New("quorum not met")
+
+// Verifies `aggSig` Over `message` Against PubKeys Selected By `bitmap`, Rejecting Before Any Pairing If Fewer Than `quorum` Bits Are Set.
+// Every Selected PubKey Is Validated Before Aggregation, So Invalid Keys Cannot Cancel Out In Sum.
+func (bls *BLS) VerifyQuorum(aggSig [3]*big.Int, allPubKeysG2 [][3][2]*big.Int, bitmap []byte, message []byte, quorum int) (bool, error) {
+	if err := checkBitmap(bitmap, len(allPubKeysG2)); err != nil {
+		return false, err
+	}
+	signers := countBitmap(bitmap)
+	if signers < quorum {
+		return false, fmt.Errorf("%w: %d signers, quorum is %d", ErrQuorumNotMet, signers, quorum)
+	}
+	selected, err := bls.selectValidPubKeys(allPubKeysG2, bitmap)
+	if err != nil {
+		return false, err
+	}
+	aggPubKeyG2, err := bls.aggregatePubKeysG2(selected)
+	if err != nil {
+		return false, fmt.Errorf("failed to aggregate selected pubKeys: %v", err)
+	}
+	return bls.VerifyBytes(aggSig, aggPubKeyG2, message)
+}
+
+// Same As VerifyQuorum Without Quorum, But Also Returns Aggregate Of Selected PubKeys So Caller Can Cache It For Next Block.
+// Every Selected PubKey Is Validated Before Aggregation, So Returned Aggregate Is Safe To Cache.
+func (bls *BLS) VerifyBitmapReturningAgg(aggSig [3]*big.Int, allPubKeysG2 [][3][2]*big.Int, bitmap []byte, message []byte) (bool, [3][2]*big.Int, error) {
+	if err := checkBitmap(bitmap, len(allPubKeysG2)); err != nil {
+		return false, [3][2]*big.Int{}, err
+	}
+	selected, err := bls.selectValidPubKeys(allPubKeysG2, bitmap)
+	if err != nil {
+		return false, [3][2]*big.Int{}, err
+	}
+	aggPubKeyG2, err := bls.aggregatePubKeysG2(selected)
+	if err != nil {
+		return false, [3][2]*big.Int{}, fmt.Errorf("failed to aggregate selected pubKeys: %v", err)
+	}
+	ok, err := bls.VerifyBytes(aggSig, aggPubKeyG2, message)
+	if err != nil {
+		return false, [3][2]*big.Int{}, err
+	}
+	return ok, aggPubKeyG2, nil
+}
+
+// Full Consensus Check Of Signed Block: Validates `aggSig` And Every Committee PubKey, Enforces `quorum` Over `bitmap`,
+// Aggregates Selected PubKeys And Verifies `aggSig` Over `blockHash`.
+func (bls *BLS) VerifyBlock(pubKeysG2 [][3][2]*big.Int, bitmap []byte, aggSig [3]*big.Int, blockHash []byte, quorum int) (bool, error) {
+	if err := bls.ValidateSignature(aggSig); err != nil {
+		return false, fmt.Errorf("invalid aggSig: %w", err)
+	}
+	if err := checkBitmap(bitmap, len(pubKeysG2)); err != nil {
+		return false, err
+	}
+	signers := countBitmap(bitmap)
+	if signers < quorum {
+		return false, fmt.Errorf("%w: %d signers, quorum is %d", ErrQuorumNotMet, signers, quorum)
+	}
+	for i, pubKey := range pubKeysG2 {
+		if err := bls.ValidatePubKey(pubKey); err != nil {
+			return false, fmt.Errorf("invalid pubKey at index %d: %w", i, err)
+		}
+	}
+	aggPubKeyG2, err := bls.aggregatePubKeysG2(selectPubKeys(pubKeysG2, bitmap))
+	if err != nil {
+		return false, fmt.Errorf("failed to aggregate selected pubKeys: %v", err)
+	}
+	if bls.bn128.G2.IsZero(aggPubKeyG2) {
+		return false, fmt.Errorf("invalid aggregate pubKey: %w", ErrPointAtInfinity)
+	}
+	return bls.verifyPoint(aggSig, aggPubKeyG2, bls.HashToG1(blockHash)), nil
+}
+
+// Reports Whether Signer Bitmaps `a` And `b` Share No Signer, `overlap` Lists Indices Set In Both.
+// Aggregates Should Only Be Combined When Their Bitmaps Are Disjoint, Otherwise Overlapping Signers Are Counted Twice.
+func BitmapsDisjoint(a, b []byte) (bool, []int) {
+	overlap := []int{}
+	for i := 0; i < len(a) && i < len(b); i++ {
+		common := a[i] & b[i]
+		for bit := 0; bit < 8; bit++ {
+			if common>>bit&1 == 1 {
+				overlap = append(overlap, i*8+bit)
+			}
+		}
+	}
+	return len(overlap) == 0, overlap
+}
+
+func checkBitmap(bitmap []byte, totalSigners int) error {
+	if len(bitmap) != (totalSigners+7)/8 {
+		return fmt.Errorf("invalid bitmap length %d for %d signers, expected %d", len(bitmap), totalSigners, (totalSigners+7)/8)
+	}
+	if totalSigners%8 != 0 && bitmap[len(bitmap)-1]>>(totalSigners%8) != 0 {
+		return fmt.Errorf("bitmap has bits set beyond %d signers", totalSigners)
+	}
+	return nil
+}
+
+func countBitmap(bitmap []byte) int {
+	count := 0
+	for _, b := range bitmap {
+		count += bits.OnesCount8(b)
+	}
+	return count
+}
+
+func bitmapHas(bitmap []byte, index int) bool {
+	return bitmap[index/8]>>(index%8)&1 == 1
+}
+
+// Same As selectPubKeys, Validating Every Selected PubKey, Error Names Its Index In `allPubKeysG2`.
+func (bls *BLS) selectValidPubKeys(allPubKeysG2 [][3][2]*big.Int, bitmap []byte) ([][3][2]*big.Int, error) {
+	selected := [][3][2]*big.Int{}
+	for i, pubKey := range allPubKeysG2 {
+		if !bitmapHas(bitmap, i) {
+			continue
+		}
+		if err := bls.ValidatePubKey(pubKey); err != nil {
+			return nil, fmt.Errorf("invalid pubKey at index %d: %w", i, err)
+		}
+		selected = append(selected, pubKey)
+	}
+	return selected, nil
+}
+
+func selectPubKeys(allPubKeysG2 [][3][2]*big.Int, bitmap []byte) [][3][2]*big.Int {
+	selected := [][3][2]*big.Int{}
+	for i, pubKey := range allPubKeysG2 {
+		if bitmapHas(bitmap, i) {
+			selected = append(selected, pubKey)
+		}
+	}
+	return selected
+}
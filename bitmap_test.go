@@ -0,0 +1,144 @@
+package bn128_bls
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+var tempPrivateKeys = []string{
+	"c18319a8f7638cd906b5e76ea0dd289a9c111fd98184bca3a727895626eba655",
+	"f0fd54e344e3c9f4064fa28ba70251fcfd71cc93a0839d2ccfa03b7c5e5d92ef",
+	"f84070afbedd4dc532ae39668b2d07856b08332cfae988199268fff1cbe960d3",
+	"d2e9a2e3d5915979a525af822388474521781c7925d3c238da3883207d758715",
+}
+
+func tempCommittee(t testing.TB) ([]*KeyPair, [][3][2]*big.Int) {
+	keyPairs := []*KeyPair{}
+	pubKeys := [][3][2]*big.Int{}
+	for _, privateKey := range tempPrivateKeys {
+		keyPair, err := bls.NewKeyPair(privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyPairs = append(keyPairs, keyPair)
+		pubKeys = append(pubKeys, keyPair.PubKey)
+	}
+	return keyPairs, pubKeys
+}
+
+func signWithBitmap(t *testing.T, keyPairs []*KeyPair, bitmap []byte, message []byte) [3]*big.Int {
+	signatures := [][3]*big.Int{}
+	for i, keyPair := range keyPairs {
+		if bitmapHas(bitmap, i) {
+			signature, err := bls.SignBytes(keyPair, message)
+			if err != nil {
+				t.Fatal(err)
+			}
+			signatures = append(signatures, signature)
+		}
+	}
+	aggSig, err := bls.AggregateSignatures(signatures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return aggSig
+}
+
+func TestVerifyQuorum(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+
+	belowQuorum := []byte{0b0101}
+	aggSig := signWithBitmap(t, keyPairs, belowQuorum, tempMessage)
+	if _, err := bls.VerifyQuorum(aggSig, pubKeys, belowQuorum, tempMessage, 3); !errors.Is(err, ErrQuorumNotMet) {
+		t.Fatalf("expected ErrQuorumNotMet, got: %v", err)
+	}
+
+	atQuorum := []byte{0b1101}
+	aggSig = signWithBitmap(t, keyPairs, atQuorum, tempMessage)
+	ok, err := bls.VerifyQuorum(aggSig, pubKeys, atQuorum, tempMessage, 3)
+	if err != nil || !ok {
+		t.Fatalf("expected quorum signature to verify, ok: %v, err: %v", ok, err)
+	}
+
+	if _, err := bls.VerifyQuorum(aggSig, pubKeys, []byte{0b1101, 0}, tempMessage, 3); err == nil {
+		t.Fatal("expected error for bitmap of wrong length")
+	}
+	if _, err := bls.VerifyQuorum(aggSig, pubKeys, []byte{0b11101}, tempMessage, 3); err == nil {
+		t.Fatal("expected error for bitmap with bits beyond signer count")
+	}
+
+	// Off-Subgroup Keys Cancelling Out In Sum Must Not Count Towards Quorum.
+	twist := randomTwistPoint(t)
+	rogueKeys := [][3][2]*big.Int{twist, bls.bn128.G2.Neg(twist), pubKeys[2], pubKeys[3]}
+	aggSig = signWithBitmap(t, keyPairs, []byte{0b1100}, tempMessage)
+	if _, err := bls.VerifyQuorum(aggSig, rogueKeys, []byte{0b1111}, tempMessage, 4); !errors.Is(err, ErrNotInSubgroup) {
+		t.Fatalf("expected ErrNotInSubgroup, got: %v", err)
+	}
+}
+
+func TestBitmapsDisjoint(t *testing.T) {
+	disjoint, overlap := BitmapsDisjoint([]byte{0b00000101, 0b1}, []byte{0b00001010})
+	if !disjoint || len(overlap) != 0 {
+		t.Fatalf("expected disjoint bitmaps, got overlap %v", overlap)
+	}
+
+	disjoint, overlap = BitmapsDisjoint([]byte{0b10000101, 0b11}, []byte{0b10000100, 0b10})
+	if disjoint || !reflect.DeepEqual(overlap, []int{2, 7, 9}) {
+		t.Fatalf("expected overlap [2 7 9], got %v", overlap)
+	}
+}
+
+func TestVerifyBlock(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	blockHash := keccak256([]byte("block 1"))
+	bitmap := []byte{0b1011}
+	aggSig := signWithBitmap(t, keyPairs, bitmap, blockHash[:])
+
+	ok, err := bls.VerifyBlock(pubKeys, bitmap, aggSig, blockHash[:], 3)
+	if err != nil || !ok {
+		t.Fatalf("expected valid block to verify, ok: %v, err: %v", ok, err)
+	}
+
+	badSig := signWithBitmap(t, keyPairs, bitmap, []byte("other block"))
+	ok, err = bls.VerifyBlock(pubKeys, bitmap, badSig, blockHash[:], 3)
+	if err != nil || ok {
+		t.Fatalf("expected block with bad signature to fail, ok: %v, err: %v", ok, err)
+	}
+
+	if _, err := bls.VerifyBlock(pubKeys, bitmap, aggSig, blockHash[:], 4); !errors.Is(err, ErrQuorumNotMet) {
+		t.Fatalf("expected ErrQuorumNotMet, got: %v", err)
+	}
+
+	offSubgroupPubKeys := append([][3][2]*big.Int{}, pubKeys...)
+	offSubgroupPubKeys[2] = randomTwistPoint(t)
+	if _, err := bls.VerifyBlock(offSubgroupPubKeys, bitmap, aggSig, blockHash[:], 3); !errors.Is(err, ErrNotInSubgroup) {
+		t.Fatalf("expected ErrNotInSubgroup, got: %v", err)
+	}
+}
+
+func TestVerifyBitmapReturningAgg(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	bitmap := []byte{0b0110}
+	aggSig := signWithBitmap(t, keyPairs, bitmap, tempMessage)
+
+	ok, aggPubKeyG2, err := bls.VerifyBitmapReturningAgg(aggSig, pubKeys, bitmap, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected aggregate to verify, ok: %v, err: %v", ok, err)
+	}
+	_, expected, _ := bls.AggregatePubKeys(
+		[][3]*big.Int{keyPairs[1].PubKeyG1, keyPairs[2].PubKeyG1},
+		[][3][2]*big.Int{keyPairs[1].PubKey, keyPairs[2].PubKey},
+	)
+	if !bls.bn128.G2.Equal(aggPubKeyG2, expected) {
+		t.Fatal("returned aggregate does not match AggregatePubKeys over selected keys")
+	}
+
+	twist := randomTwistPoint(t)
+	rogueKeys := [][3][2]*big.Int{pubKeys[0], twist, bls.bn128.G2.Neg(twist), pubKeys[3]}
+	aggSig = signWithBitmap(t, keyPairs, []byte{0b1001}, tempMessage)
+	if _, _, err := bls.VerifyBitmapReturningAgg(aggSig, rogueKeys, []byte{0b1111}, tempMessage); !errors.Is(err, ErrNotInSubgroup) {
+		t.Fatalf("expected ErrNotInSubgroup, got: %v", err)
+	}
+}
@@ -5,22 +5,70 @@ package bn128_bls
 
 import (
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"log"
 	"math/big"
+	"sync/atomic"
+	"time"
 
 	bn128PKG "github.com/arnaucube/go-snark/bn128"
 )
 
 type BLS struct {
-	bn128          bn128PKG.Bn128
-	privateKeySize int
+	bn128             bn128PKG.Bn128
+	privateKeySize    int
+	dst               []byte
+	mapViaScalarField bool
+	hashToCurve       HashToCurveMethod
+
+	deterministicBatchCoefficients bool
+	allowEmptyAggregate            bool
+	legacyG1CofactorClearing       bool
+
+	tables *precomputedTables
+
+	// Set By First Sign Or Verify, Locks Hashing Configuration.
+	used atomic.Bool
+
+	clockSkew time.Duration
+	// Longest Message Accepted By PrefilterSignature, Zero Means No Limit.
+	prefilterMaxMessageSize int
+	// Returns Current Time For Expiry Checks, time.Now Is Used If Nil.
+	clock func() time.Time
+
+	// Called On Every G2 Subgroup Check, Only Set By Tests.
+	subgroupCheckHook func(point [3][2]*big.Int)
+	// Called With Number Of Pairings Of Every Pairing Check, Only Set By Tests.
+	pairingHook func(pairings int)
 }
 
 type KeyPair struct {
 	PrivateKey *big.Int
 	PubKey     [3][2]*big.Int
 	PubKeyG1   [3]*big.Int
+	destroyed  bool
+}
+
+var ErrKeyDestroyed = errors.New("keyPair has been destroyed")
+
+var ErrDecimalCoordinates = errors.New("message point is not on curve and coordinates contain only decimal digits, they may be decimal instead of hex")
+
+// Overwrites Private Key In Memory, Signing With Destroyed KeyPair Returns ErrKeyDestroyed Instead Of Zero-Key Signature.
+// PubKeys Are Kept, So KeyPair Can Still Be Used To Identify Signer.
+func (keyPair *KeyPair) Destroy() {
+	if keyPair.PrivateKey != nil {
+		words := keyPair.PrivateKey.Bits()
+		for i := range words {
+			words[i] = 0
+		}
+		keyPair.PrivateKey.SetInt64(0)
+	}
+	keyPair.destroyed = true
+}
+
+func (keyPair *KeyPair) IsDestroyed() bool {
+	return keyPair.destroyed
 }
 
 func NewBls() *BLS {
@@ -31,20 +79,24 @@ func NewBls() *BLS {
 	return &BLS{
 		bn128:          bn128,
 		privateKeySize: 256,
+		dst:            []byte(DefaultDST),
+		tables:         &precomputedTables{},
 	}
 }
 
+// Deprecated: GenerateRandomKeyPair Samples Uniformly From [1, R-1] Using RandomScalar, Size Is Only Kept In Profile.
+// Not Locked After First Use Since It Is Left Out Of ConfigFingerprint And So Of Memo Keys And Dispute Bundles.
 func (bls *BLS) SetPrivateKeySize(newPrivateKeySize int) {
 	bls.privateKeySize = newPrivateKeySize
 }
 
 func (bls *BLS) GenerateRandomKeyPair() (*KeyPair, error) {
-	privateKey, err := rand.Prime(rand.Reader, bls.privateKeySize)
+	privateKey, err := randomScalar(rand.Reader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate private key: %v", err)
 	}
-	pubKey := bls.bn128.G2.MulScalar(bls.bn128.G2.G, privateKey)
-	pubKeyG1 := bls.bn128.G1.MulScalar(bls.bn128.G1.G, privateKey)
+	pubKey := bls.mulBaseG2(privateKey)
+	pubKeyG1 := bls.mulBaseG1(privateKey)
 	return &KeyPair{
 		PrivateKey: privateKey,
 		PubKey:     pubKey,
@@ -52,13 +104,19 @@ func (bls *BLS) GenerateRandomKeyPair() (*KeyPair, error) {
 	}, nil
 }
 
+// Longest Accepted `privateKeyHexStr` Of NewKeyPair, Every Valid Key Fits Into 64 Hex Chars.
+const maxPrivateKeyHexSize = 80
+
 func (bls *BLS) NewKeyPair(privateKeyHexStr string) (*KeyPair, error) {
+	if len(privateKeyHexStr) > maxPrivateKeyHexSize {
+		return nil, fmt.Errorf("privateKeyHexStr is too long, got %d chars, max is %d", len(privateKeyHexStr), maxPrivateKeyHexSize)
+	}
 	privateKey, ok := new(big.Int).SetString(privateKeyHexStr, 16)
 	if !ok {
 		return nil, fmt.Errorf("invalid privateKeyHexStr")
 	}
-	pubKey := bls.bn128.G2.MulScalar(bls.bn128.G2.G, privateKey)
-	pubKeyG1 := bls.bn128.G1.MulScalar(bls.bn128.G1.G, privateKey)
+	pubKey := bls.mulBaseG2(privateKey)
+	pubKeyG1 := bls.mulBaseG1(privateKey)
 	return &KeyPair{
 		PrivateKey: privateKey,
 		PubKey:     pubKey,
@@ -84,40 +142,120 @@ func (bls *BLS) ParsePubKeyG2(pubKeyG2 [3][2]*big.Int) [2][2]*big.Int {
 	return [2][2]*big.Int{resData[0], resData[1]}
 }
 
+// Checked Variants Of Parse Methods, They Return *PointError Instead Of Panicking When Point Has Nil Coordinate Or Is Not On Curve.
+// Use Them For Points Decoded From Untrusted Sources, Subgroup Membership Of PubKeys Is Checked By ValidatePubKey.
+func (bls *BLS) ParsePubKeyChecked(pubKey [3][2]*big.Int) ([4]*big.Int, error) {
+	if err := bls.checkPointG2("pubKey", pubKey); err != nil {
+		return [4]*big.Int{}, err
+	}
+	return bls.ParsePubKey(pubKey), nil
+}
+
+func (bls *BLS) ParsePubKeyG1Checked(pubKeyG1 [3]*big.Int) ([2]*big.Int, error) {
+	if err := bls.checkPointG1("pubKeyG1", pubKeyG1); err != nil {
+		return [2]*big.Int{}, err
+	}
+	return bls.ParsePubKeyG1(pubKeyG1), nil
+}
+
+func (bls *BLS) ParsePubKeyG2Checked(pubKeyG2 [3][2]*big.Int) ([2][2]*big.Int, error) {
+	if err := bls.checkPointG2("pubKeyG2", pubKeyG2); err != nil {
+		return [2][2]*big.Int{}, err
+	}
+	return bls.ParsePubKeyG2(pubKeyG2), nil
+}
+
+func (bls *BLS) ParseSignatureChecked(signature [3]*big.Int) ([2]*big.Int, error) {
+	if err := bls.checkPointG1("signature", signature); err != nil {
+		return [2]*big.Int{}, err
+	}
+	return bls.ParseSignature(signature), nil
+}
+
 // Perform HashToPoint Operation On Your Message And Obtained Two BigIntsHexStr, Pass Them In This Method.
 // First HexStr: messageXHexStr
 // Second HexStr: messageYHexStr
 func (bls *BLS) GenerateSignature(keyPair *KeyPair, messageXHexStr string, messageYHexStr string) ([3]*big.Int, error) {
-	messageX, ok := new(big.Int).SetString(messageXHexStr, 16)
-	if !ok {
-		return [3]*big.Int{}, fmt.Errorf("failed to generate messageX, invalid `messageXHexStr`")
-	}
-	messageY, ok := new(big.Int).SetString(messageYHexStr, 16)
-	if !ok {
-		return [3]*big.Int{}, fmt.Errorf("failed to generate messageY, invalid `messageYHexStr`")
+	messageG1, err := bls.parseMessagePoint(messageXHexStr, messageYHexStr)
+	if err != nil {
+		return [3]*big.Int{}, err
 	}
-	messageG1 := bn128PKG.NewG1(bls.bn128.Fq1, [2]*big.Int{messageX, messageY})
-	signature := bls.bn128.G1.MulScalar(messageG1.G, keyPair.PrivateKey)
-	return signature, nil
+	return bls.signPoint(keyPair, messageG1)
 }
 
 func (bls *BLS) ParseSignature(signature [3]*big.Int) [2]*big.Int {
 	return bls.bn128.G1.Affine(signature)
 }
 
+// Note: This Method Does Not Validate `signature` Or `signerPubKey`, They Are Assumed To Be On Curve And In Subgroup.
+// It Only Rejects Coordinates (Including Message Ones) Which Are Not Reduced Modulo Q.
+// For Untrusted Inputs Use VerifyBytes, Or Call ValidateSignature And ValidatePubKey First.
 func (bls *BLS) VerifySignature(signature [3]*big.Int, signerPubKey [3][2]*big.Int, messageXHexStr string, messageYHexStr string) (bool, error) {
+	messagePoint, err := bls.parseMessagePoint(messageXHexStr, messageYHexStr)
+	if err != nil {
+		return false, err
+	}
+	messageG1, err := bls.NormalizePointG1(messagePoint, true)
+	if err != nil {
+		return false, fmt.Errorf("invalid message point: %w", err)
+	}
+	if _, err := bls.NormalizePointG1(signature, true); err != nil {
+		return false, fmt.Errorf("invalid signature: %w", err)
+	}
+	if _, err := bls.NormalizePointG2(signerPubKey, true); err != nil {
+		return false, fmt.Errorf("invalid signerPubKey: %w", err)
+	}
+	return bls.verifyPoint(signature, signerPubKey, messageG1), nil
+}
+
+// Parses Hex Message Coordinates, Off-Curve Points Made Only Of Decimal Digits Return ErrDecimalCoordinates,
+// Since Decimal Strings Are Silently Accepted As Hex By SetString.
+func (bls *BLS) parseMessagePoint(messageXHexStr string, messageYHexStr string) ([3]*big.Int, error) {
 	messageX, ok := new(big.Int).SetString(messageXHexStr, 16)
 	if !ok {
-		return false, fmt.Errorf("failed to generate messageX, invalid `messageXHexStr`")
+		return [3]*big.Int{}, fmt.Errorf("failed to generate messageX, invalid `messageXHexStr`")
 	}
 	messageY, ok := new(big.Int).SetString(messageYHexStr, 16)
 	if !ok {
-		return false, fmt.Errorf("failed to generate messageY, invalid `messageYHexStr`")
+		return [3]*big.Int{}, fmt.Errorf("failed to generate messageY, invalid `messageYHexStr`")
 	}
-	messageG1 := bn128PKG.NewG1(bls.bn128.Fq1, [2]*big.Int{messageX, messageY})
-	pair1 := bls.bn128.Pairing(messageG1.G, signerPubKey)
-	pair2 := bls.bn128.Pairing(signature, bls.bn128.G2.G)
-	return bls.bn128.Fq12.Equal(pair1, pair2), nil
+	messageG1 := bls.NewG1([2]*big.Int{messageX, messageY})
+	if isDecimalDigits(messageXHexStr) && isDecimalDigits(messageYHexStr) && !bls.IsOnCurveG1(messageG1) {
+		return [3]*big.Int{}, ErrDecimalCoordinates
+	}
+	return messageG1, nil
+}
+
+func isDecimalDigits(str string) bool {
+	for _, c := range str {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return str != ""
+}
+
+func (bls *BLS) signPoint(keyPair *KeyPair, messageG1 [3]*big.Int) ([3]*big.Int, error) {
+	bls.used.Store(true)
+	if keyPair == nil {
+		return [3]*big.Int{}, fmt.Errorf("keyPair is nil")
+	}
+	if keyPair.destroyed {
+		return [3]*big.Int{}, ErrKeyDestroyed
+	}
+	// Reduced Same Way As In mulBaseG1/mulBaseG2, go-snark Would Multiply By |k| For Negative Key.
+	return bls.bn128.G1.MulScalar(messageG1, new(big.Int).Mod(keyPair.PrivateKey, curveOrder)), nil
+}
+
+func (bls *BLS) verifyPoint(signature [3]*big.Int, signerPubKey [3][2]*big.Int, messageG1 [3]*big.Int) bool {
+	return bls.verifyPointWithGenerator(signature, signerPubKey, messageG1, bls.bn128.G2.G)
+}
+
+func (bls *BLS) verifyPointWithGenerator(signature [3]*big.Int, signerPubKey [3][2]*big.Int, messageG1 [3]*big.Int, g2Generator [3][2]*big.Int) bool {
+	return bls.pairingCheck(
+		[][3]*big.Int{messageG1, bls.bn128.G1.Neg(signature)},
+		[][3][2]*big.Int{signerPubKey, g2Generator},
+	)
 }
 
 func (bls *BLS) AggregatePubKeys(pubKeysG1 [][3]*big.Int, pubKeysG2 [][3][2]*big.Int) ([3]*big.Int, [3][2]*big.Int, error) {
@@ -132,15 +270,15 @@ func (bls *BLS) AggregatePubKeys(pubKeysG1 [][3]*big.Int, pubKeysG2 [][3][2]*big
 		return aggregatedG1, aggregatedG2, fmt.Errorf("zero pubKeysG1 and pubKeysG2 are passed")
 	}
 	if totalPubKeys < 2 {
-		return pubKeysG1[0], pubKeysG2[0], nil
+		return CloneG1(pubKeysG1[0]), CloneG2(pubKeysG2[0]), nil
 	}
 	aggregatedG1 = pubKeysG1[0]
 	aggregatedG2 = pubKeysG2[0]
 	for i := 1; i < totalPubKeys; i++ {
-		aggregatedG1 = bls.bn128.G1.Add(aggregatedG1, pubKeysG1[i])
-		aggregatedG2 = bls.bn128.G2.Add(aggregatedG2, pubKeysG2[i])
+		aggregatedG1 = bls.addG1(aggregatedG1, pubKeysG1[i])
+		aggregatedG2 = bls.addG2(aggregatedG2, pubKeysG2[i])
 	}
-	return aggregatedG1, aggregatedG2, nil
+	return CloneG1(aggregatedG1), CloneG2(aggregatedG2), nil
 }
 
 func (bls *BLS) AggregateSignatures(signatures [][3]*big.Int) ([3]*big.Int, error) {
@@ -150,19 +288,71 @@ func (bls *BLS) AggregateSignatures(signatures [][3]*big.Int) ([3]*big.Int, erro
 		return aggregatedSignature, fmt.Errorf("no signature have been passed")
 	}
 	if totalSignatures < 2 {
-		return signatures[0], nil
+		return CloneG1(signatures[0]), nil
 	}
 	aggregatedSignature = signatures[0]
 	for i := 1; i < totalSignatures; i++ {
-		aggregatedSignature = bls.bn128.G1.Add(aggregatedSignature, signatures[i])
+		aggregatedSignature = bls.addG1(aggregatedSignature, signatures[i])
+	}
+	return CloneG1(aggregatedSignature), nil
+}
+
+// Returns Sum Of Private Keys Modulo R, Signature Under This Sum Equals Aggregate Of Individual Signatures.
+// Meant For Tests Of Aggregation, Real Deployments Should Never Collect Private Keys In One Place.
+func (bls *BLS) SumPrivateKeys(kps []*KeyPair) *big.Int {
+	sum := big.NewInt(0)
+	for _, kp := range kps {
+		sum.Add(sum, kp.PrivateKey)
+	}
+	return sum.Mod(sum, bls.bn128.R)
+}
+
+// Aggregates `claimedMembers` And Checks Whether Result Equals `aggPubKeyG2`, Useful For Auditing Signer Set Claimed By Relayer.
+// Note: It Only Compares Sums, So It Cannot Distinguish Two Different Sets Whose PubKeys Add Up To Same Point.
+func (bls *BLS) VerifyAggregateMembership(aggPubKeyG2 [3][2]*big.Int, claimedMembers [][3][2]*big.Int) (bool, error) {
+	aggregatedG2, err := bls.aggregatePubKeysG2(claimedMembers)
+	if err != nil {
+		return false, fmt.Errorf("failed to aggregate claimedMembers: %v", err)
 	}
-	return aggregatedSignature, nil
+	return bls.bn128.G2.Equal(aggregatedG2, aggPubKeyG2), nil
+}
+
+func (bls *BLS) aggregatePubKeysG2(pubKeysG2 [][3][2]*big.Int) ([3][2]*big.Int, error) {
+	totalPubKeys := len(pubKeysG2)
+	if totalPubKeys < 1 {
+		return [3][2]*big.Int{}, fmt.Errorf("zero pubKeysG2 are passed")
+	}
+	aggregatedG2 := pubKeysG2[0]
+	for i := 1; i < totalPubKeys; i++ {
+		aggregatedG2 = bls.addG2(aggregatedG2, pubKeysG2[i])
+	}
+	return aggregatedG2, nil
 }
 
 func (bls *BLS) NewG1(g1 [2]*big.Int) [3]*big.Int {
 	return bn128PKG.NewG1(bls.bn128.Fq1, g1).G
 }
 
+func (bls *BLS) zeroG1() [3]*big.Int {
+	return [3]*big.Int{bls.bn128.Fq1.Zero(), bls.bn128.Fq1.One(), bls.bn128.Fq1.Zero()}
+}
+
 func (bls *BLS) NewG2(g2 [2][2]*big.Int) [3][2]*big.Int {
 	return bn128PKG.NewG2(bls.bn128.Fq2, g2).G
 }
+
+// Same As G1.Add But Doubles Equal Inputs, bn128 Add Has No Doubling Case And Returns Infinity For p + p.
+func (bls *BLS) addG1(p1, p2 [3]*big.Int) [3]*big.Int {
+	if !bls.bn128.G1.IsZero(p1) && bls.bn128.G1.Equal(p1, p2) {
+		return bls.bn128.G1.Double(p1)
+	}
+	return bls.bn128.G1.Add(p1, p2)
+}
+
+// Same As G2.Add But Doubles Equal Inputs, See addG1.
+func (bls *BLS) addG2(p1, p2 [3][2]*big.Int) [3][2]*big.Int {
+	if !bls.bn128.G2.IsZero(p1) && bls.bn128.G2.Equal(p1, p2) {
+		return bls.bn128.G2.Double(p1)
+	}
+	return bls.bn128.G2.Add(p1, p2)
+}
@@ -1,8 +1,10 @@
 package bn128_bls
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 )
 
@@ -16,6 +18,9 @@ var bls *BLS = NewBls()
 func TestRandKeyPairGeneration(t *testing.T) {
 	fmt.Println("Checking Rand KeyPair")
 	randKeyPair, _ := bls.GenerateRandomKeyPair()
+	if !IsValidPrivateKey(randKeyPair.PrivateKey) {
+		t.Fatalf("expected random private key in [1, R-1], got %s", randKeyPair.PrivateKey.Text(16))
+	}
 	fmt.Printf("PrivateKey: %s\n\n", randKeyPair.PrivateKey.Text(16))
 	fmt.Printf("PubKey: %#v\n\n", bls.ParsePubKey(randKeyPair.PubKey))
 	fmt.Println(bls.GenerateSignature(randKeyPair, tempMessageX, tempMessageY))
@@ -31,6 +36,26 @@ func TestExistingKeyPair(t *testing.T) {
 	fmt.Println(bls.VerifySignature(signature, existingKeyPair.PubKey, tempMessageX, tempMessageY))
 }
 
+func TestNewKeyPairRejectsLongHex(t *testing.T) {
+	if _, err := bls.NewKeyPair(strings.Repeat("ab", 4096)); err == nil {
+		t.Fatal("expected error for absurdly long private key hex")
+	}
+	if _, err := bls.NewKeyPair(strings.Repeat("0", 16) + "cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f"); err != nil {
+		t.Fatalf("expected 80 hex chars to be accepted, got: %v", err)
+	}
+}
+
+func TestNewKeyPairNegativeKey(t *testing.T) {
+	negativeKeyPair, err := bls.NewKeyPair("-cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, _ := bls.SignBytes(negativeKeyPair, tempMessage)
+	if ok, err := bls.VerifyBytes(signature, negativeKeyPair.PubKey, tempMessage); err != nil || !ok {
+		t.Fatalf("expected negative key to verify its own signature, ok: %v, err: %v", ok, err)
+	}
+}
+
 func TestPubKeyAndSignatureAggregation(t *testing.T) {
 	fmt.Println("Checking Aggregated PubKey And Signature")
 	keyPair1, _ := bls.NewKeyPair("c18319a8f7638cd906b5e76ea0dd289a9c111fd98184bca3a727895626eba655")
@@ -98,6 +123,178 @@ func TestPubKeyAndSignatureAggregation(t *testing.T) {
 	*/
 }
 
+func TestVerifyAggregateMembership(t *testing.T) {
+	keyPair1, _ := bls.NewKeyPair("c18319a8f7638cd906b5e76ea0dd289a9c111fd98184bca3a727895626eba655")
+	keyPair2, _ := bls.NewKeyPair("f0fd54e344e3c9f4064fa28ba70251fcfd71cc93a0839d2ccfa03b7c5e5d92ef")
+	keyPair3, _ := bls.NewKeyPair("f84070afbedd4dc532ae39668b2d07856b08332cfae988199268fff1cbe960d3")
+
+	_, aggregatedPubKeyG2, _ := bls.AggregatePubKeys([][3]*big.Int{
+		keyPair1.PubKeyG1,
+		keyPair2.PubKeyG1,
+		keyPair3.PubKeyG1,
+	}, [][3][2]*big.Int{
+		keyPair1.PubKey,
+		keyPair2.PubKey,
+		keyPair3.PubKey,
+	})
+
+	ok, err := bls.VerifyAggregateMembership(aggregatedPubKeyG2, [][3][2]*big.Int{
+		keyPair3.PubKey,
+		keyPair1.PubKey,
+		keyPair2.PubKey,
+	})
+	if err != nil || !ok {
+		t.Fatalf("expected exact member set to match aggregate, ok: %v, err: %v", ok, err)
+	}
+
+	ok, err = bls.VerifyAggregateMembership(aggregatedPubKeyG2, [][3][2]*big.Int{
+		keyPair1.PubKey,
+		keyPair2.PubKey,
+	})
+	if err != nil || ok {
+		t.Fatalf("expected member set missing one signer to fail, ok: %v, err: %v", ok, err)
+	}
+
+	if _, err := bls.VerifyAggregateMembership(aggregatedPubKeyG2, nil); err == nil {
+		t.Fatal("expected error for empty claimedMembers")
+	}
+}
+
+// go-snark Add Returns Infinity For p + p, So Repeated PubKey Must Be Doubled Instead Of Cancelled.
+func TestAggregateRepeatedPubKey(t *testing.T) {
+	keyPair1, _ := bls.NewKeyPair("c18319a8f7638cd906b5e76ea0dd289a9c111fd98184bca3a727895626eba655")
+	keyPair2, _ := bls.NewKeyPair("f0fd54e344e3c9f4064fa28ba70251fcfd71cc93a0839d2ccfa03b7c5e5d92ef")
+	pubKeys := [][3][2]*big.Int{keyPair1.PubKey, keyPair1.PubKey, keyPair2.PubKey}
+
+	signature2, _ := bls.SignBytes(keyPair2, tempMessage)
+	if ok, _ := bls.FastAggregateVerify(signature2, pubKeys, tempMessage); ok {
+		t.Fatal("expected signature of second key alone to fail when first key is listed twice")
+	}
+
+	signature1, _ := bls.SignBytes(keyPair1, tempMessage)
+	aggSig, _ := bls.AggregateSignatures([][3]*big.Int{signature1, signature1, signature2})
+	expected := bls.bn128.G1.Add(bls.bn128.G1.MulScalar(signature1, big.NewInt(2)), signature2)
+	if !bls.bn128.G1.Equal(aggSig, expected) {
+		t.Fatal("expected repeated signature to be doubled")
+	}
+	ok, err := bls.FastAggregateVerify(aggSig, pubKeys, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected aggregate with repeated signer to verify, ok: %v, err: %v", ok, err)
+	}
+	aggregatedPubKeyG2, _ := bls.aggregatePubKeysG2(pubKeys)
+	if ok, _ := bls.VerifyAggregateMembership(aggregatedPubKeyG2, pubKeys[2:]); ok {
+		t.Fatal("expected membership of second key alone to fail")
+	}
+}
+
+func TestSumPrivateKeys(t *testing.T) {
+	keyPair1, _ := bls.NewKeyPair("c18319a8f7638cd906b5e76ea0dd289a9c111fd98184bca3a727895626eba655")
+	keyPair2, _ := bls.NewKeyPair("f0fd54e344e3c9f4064fa28ba70251fcfd71cc93a0839d2ccfa03b7c5e5d92ef")
+	keyPair3, _ := bls.NewKeyPair("f84070afbedd4dc532ae39668b2d07856b08332cfae988199268fff1cbe960d3")
+	keyPairs := []*KeyPair{keyPair1, keyPair2, keyPair3}
+
+	signatures := [][3]*big.Int{}
+	for _, keyPair := range keyPairs {
+		signature, _ := bls.GenerateSignature(keyPair, tempMessageX, tempMessageY)
+		signatures = append(signatures, signature)
+	}
+	aggregatedSignature, _ := bls.AggregateSignatures(signatures)
+
+	summedKeyPair, err := bls.NewKeyPair(bls.SumPrivateKeys(keyPairs).Text(16))
+	if err != nil {
+		t.Fatal(err)
+	}
+	summedSignature, _ := bls.GenerateSignature(summedKeyPair, tempMessageX, tempMessageY)
+	if !bls.bn128.G1.Equal(aggregatedSignature, summedSignature) {
+		t.Fatal("aggregate signature does not equal signature under summed private key")
+	}
+
+	_, aggregatedPubKeyG2, _ := bls.AggregatePubKeys([][3]*big.Int{
+		keyPair1.PubKeyG1,
+		keyPair2.PubKeyG1,
+		keyPair3.PubKeyG1,
+	}, [][3][2]*big.Int{
+		keyPair1.PubKey,
+		keyPair2.PubKey,
+		keyPair3.PubKey,
+	})
+	if !bls.bn128.G2.Equal(aggregatedPubKeyG2, summedKeyPair.PubKey) {
+		t.Fatal("aggregate pubKey does not equal pubKey of summed private key")
+	}
+}
+
+func TestSignAfterDestroy(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	keyPair.Destroy()
+	if !keyPair.IsDestroyed() || keyPair.PrivateKey.Sign() != 0 {
+		t.Fatal("private key was not wiped")
+	}
+	if _, err := bls.GenerateSignature(keyPair, tempMessageX, tempMessageY); !errors.Is(err, ErrKeyDestroyed) {
+		t.Fatalf("expected ErrKeyDestroyed, got: %v", err)
+	}
+	if _, err := bls.SignBytes(keyPair, tempMessage); !errors.Is(err, ErrKeyDestroyed) {
+		t.Fatalf("expected ErrKeyDestroyed, got: %v", err)
+	}
+}
+
+func TestCheckedParse(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.GenerateSignature(keyPair, tempMessageX, tempMessageY)
+
+	if _, err := bls.ParsePubKeyChecked(keyPair.PubKey); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bls.ParsePubKeyG1Checked(keyPair.PubKeyG1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bls.ParsePubKeyG2Checked(keyPair.PubKey); err != nil {
+		t.Fatal(err)
+	}
+	if parsed, err := bls.ParseSignatureChecked(signature); err != nil || parsed[0].Cmp(bls.ParseSignature(signature)[0]) != 0 {
+		t.Fatalf("unexpected checked signature parse result, err: %v", err)
+	}
+
+	nilPubKey := keyPair.PubKey
+	nilPubKey[1] = [2]*big.Int{nil, keyPair.PubKey[1][1]}
+	nilSignature := [3]*big.Int{signature[0], nil, signature[2]}
+	var pointErr *PointError
+	if _, err := bls.ParsePubKeyChecked(nilPubKey); !errors.As(err, &pointErr) || !errors.Is(err, ErrNilCoordinate) {
+		t.Fatalf("expected nil coordinate PointError, got: %v", err)
+	}
+	if _, err := bls.ParsePubKeyG2Checked(nilPubKey); !errors.Is(err, ErrNilCoordinate) {
+		t.Fatalf("expected ErrNilCoordinate, got: %v", err)
+	}
+	if _, err := bls.ParsePubKeyG1Checked(nilSignature); !errors.Is(err, ErrNilCoordinate) {
+		t.Fatalf("expected ErrNilCoordinate, got: %v", err)
+	}
+	if _, err := bls.ParseSignatureChecked(nilSignature); !errors.As(err, &pointErr) || pointErr.Point != "signature" {
+		t.Fatalf("expected signature PointError, got: %v", err)
+	}
+
+	offCurveSignature := [3]*big.Int{signature[0], big.NewInt(1), signature[2]}
+	if _, err := bls.ParseSignatureChecked(offCurveSignature); !errors.Is(err, ErrNotOnCurve) {
+		t.Fatalf("expected ErrNotOnCurve, got: %v", err)
+	}
+}
+
+func TestDecimalCoordinates(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	messageX, _ := new(big.Int).SetString(tempMessageX, 16)
+	messageY, _ := new(big.Int).SetString(tempMessageY, 16)
+	decimalX, decimalY := messageX.String(), messageY.String()
+
+	if _, err := bls.GenerateSignature(keyPair, decimalX, decimalY); !errors.Is(err, ErrDecimalCoordinates) {
+		t.Fatalf("expected ErrDecimalCoordinates, got: %v", err)
+	}
+	signature, _ := bls.GenerateSignature(keyPair, tempMessageX, tempMessageY)
+	if _, err := bls.VerifySignature(signature, keyPair.PubKey, decimalX, decimalY); !errors.Is(err, ErrDecimalCoordinates) {
+		t.Fatalf("expected ErrDecimalCoordinates, got: %v", err)
+	}
+	if !strings.Contains(ErrDecimalCoordinates.Error(), "decimal instead of hex") {
+		t.Fatal("expected error message to suggest decimal input")
+	}
+}
+
 // func TestTemp(t *testing.T) {
 // 	keyPair1, _ := bls.NewKeyPair("c18319a8f7638cd906b5e76ea0dd289a9c111fd98184bca3a727895626eba655")
 // 	// keyPair2, _ := bls.NewKeyPair("f0fd54e344e3c9f4064fa28ba70251fcfd71cc93a0839d2ccfa03b7c5e5d92ef")
@@ -0,0 +1,36 @@
+package bn128_bls
+
+// Signature Chaining, Message Of Chained Signature Is Keccak256 Of Prior Signature's 64 Byte Canonical Encoding,
+// Hashed Under Separate Chain DST "BN128_BLS_CHAIN_" || DST So It Differs From SignBytes Over Same 32 Bytes.
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const chainDSTPrefix = "BN128_BLS_CHAIN_"
+
+// Signs Over `prior` Signature, Whatever Jacobian Representation It Is Given In.
+func (bls *BLS) SignOverSignature(keyPair *KeyPair, prior [3]*big.Int) ([3]*big.Int, error) {
+	digest, err := bls.signatureChainDigest(prior)
+	if err != nil {
+		return [3]*big.Int{}, err
+	}
+	return bls.signForPurpose(keyPair, chainDSTPrefix, digest[:])
+}
+
+// Verifies Signature Produced By SignOverSignature.
+func (bls *BLS) VerifyOverSignature(signature [3]*big.Int, signerPubKey [3][2]*big.Int, prior [3]*big.Int) (bool, error) {
+	digest, err := bls.signatureChainDigest(prior)
+	if err != nil {
+		return false, err
+	}
+	return bls.verifyForPurpose(signature, signerPubKey, chainDSTPrefix, digest[:])
+}
+
+func (bls *BLS) signatureChainDigest(prior [3]*big.Int) ([32]byte, error) {
+	if err := bls.ValidateSignature(prior); err != nil {
+		return [32]byte{}, fmt.Errorf("invalid prior signature: %w", err)
+	}
+	return bls.SignatureDigest(prior), nil
+}
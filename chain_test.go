@@ -0,0 +1,47 @@
+package bn128_bls
+
+import (
+	"math/big"
+	"testing"
+)
+
+// Returns Same Point In Different Jacobian Representation (x*l^2, y*l^3, z*l).
+func rescaleG1(point [3]*big.Int, l int64) [3]*big.Int {
+	fq := bls.bn128.Fq1
+	lambda := big.NewInt(l)
+	lambda2 := fq.Square(lambda)
+	return [3]*big.Int{
+		fq.Mul(point[0], lambda2),
+		fq.Mul(point[1], fq.Mul(lambda2, lambda)),
+		fq.Mul(point[2], lambda),
+	}
+}
+
+func TestSignOverSignature(t *testing.T) {
+	keyPairs, _ := tempCommittee(t)
+	first, _ := bls.SignBytes(keyPairs[0], tempMessage)
+	second, err := bls.SignOverSignature(keyPairs[1], first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rescaledSecond, err := bls.SignOverSignature(keyPairs[1], rescaleG1(first, 7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bls.bn128.G1.Equal(second, rescaledSecond) {
+		t.Fatal("chained signature depends on jacobian representation of prior")
+	}
+
+	ok, err := bls.VerifyOverSignature(second, keyPairs[1].PubKey, rescaleG1(first, 11))
+	if err != nil || !ok {
+		t.Fatalf("expected chained signature to verify, ok: %v, err: %v", ok, err)
+	}
+	ok, err = bls.VerifyOverSignature(second, keyPairs[1].PubKey, second)
+	if err != nil || ok {
+		t.Fatalf("expected chained signature over wrong prior to fail, ok: %v, err: %v", ok, err)
+	}
+	digest := bls.SignatureDigest(first)
+	if ok, _ := bls.VerifyBytes(second, keyPairs[1].PubKey, digest[:]); ok {
+		t.Fatal("expected chained signature not to verify as plain signature over digest")
+	}
+}
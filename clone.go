@@ -0,0 +1,38 @@
+package bn128_bls
+
+// Deep Copies Of Points. Points Are Arrays Of *big.Int, So Copying Array Still Shares Coordinates,
+// And bn128 Arithmetic Returns Its Inputs Unchanged In Some Cases (e.g., P + O = P).
+// Methods Below Return Clones, So Mutating Their Results Never Affects Inputs Or Internal State:
+//   - NewKeyPair, NewKeyPairFromSeed, GenerateRandomKeyPair, ParsePKCS8 (KeyPair PubKeys, Else They Could Alias Precomputed Tables).
+//   - AggregateSignatures, AggregatePubKeys.
+//   - CommitteeVerifier.AggregatePubKey And CommitteeVerifier.Members.
+// Other Methods May Return Points Sharing Coordinates With Their Inputs, Clone Them Before Mutating In Place.
+
+import (
+	"math/big"
+)
+
+// Returns Copy Of G1 `point` With Freshly Allocated Coordinates, Nil Coordinates Stay Nil.
+func CloneG1(point [3]*big.Int) [3]*big.Int {
+	var clone [3]*big.Int
+	for i, coordinate := range point {
+		clone[i] = cloneInt(coordinate)
+	}
+	return clone
+}
+
+// G2 Version Of CloneG1.
+func CloneG2(point [3][2]*big.Int) [3][2]*big.Int {
+	var clone [3][2]*big.Int
+	for i, coordinate := range point {
+		clone[i] = [2]*big.Int{cloneInt(coordinate[0]), cloneInt(coordinate[1])}
+	}
+	return clone
+}
+
+func cloneInt(x *big.Int) *big.Int {
+	if x == nil {
+		return nil
+	}
+	return new(big.Int).Set(x)
+}
@@ -0,0 +1,46 @@
+package bn128_bls
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCloneG1(t *testing.T) {
+	point := bls.bn128.G1.G
+	clone := CloneG1(point)
+	clone[0].SetInt64(7)
+	if point[0].Cmp(big.NewInt(7)) == 0 {
+		t.Fatal("mutating clone changed original")
+	}
+	if CloneG1([3]*big.Int{})[0] != nil {
+		t.Fatal("expected nil coordinate to stay nil")
+	}
+}
+
+func TestReturnedPointsAreIndependent(t *testing.T) {
+	freshBls := NewBls()
+	// Private Key 1 Makes mulBaseG2 Pick Generator Entry From Precomputed Table.
+	keyPair, _ := freshBls.NewKeyPair("1")
+	keyPair.PubKey[0][0].SetInt64(0)
+	keyPair.PubKeyG1[0].SetInt64(0)
+	other, _ := freshBls.NewKeyPair("1")
+	if !freshBls.IsOnCurveG2(other.PubKey) || !freshBls.IsOnCurveG1(other.PubKeyG1) {
+		t.Fatal("mutating returned pubKey corrupted precomputed table")
+	}
+
+	signature, _ := freshBls.SignBytes(other, tempMessage)
+	aggSig, _ := freshBls.AggregateSignatures([][3]*big.Int{signature})
+	aggSig[0].SetInt64(0)
+	if !freshBls.IsOnCurveG1(signature) {
+		t.Fatal("mutating aggregate changed input signature")
+	}
+
+	_, pubKeys := tempCommittee(t)
+	committeeVerifier, _ := freshBls.NewCommitteeVerifier(pubKeys)
+	aggPubKey := committeeVerifier.AggregatePubKey()
+	aggPubKey[0][0].SetInt64(0)
+	committeeVerifier.Members()[0][0][0].SetInt64(0)
+	if !freshBls.IsOnCurveG2(committeeVerifier.AggregatePubKey()) || !freshBls.IsOnCurveG2(committeeVerifier.Members()[0]) {
+		t.Fatal("mutating returned points changed committee state")
+	}
+}
@@ -0,0 +1,30 @@
+package bn128_bls
+
+// Commit-Then-Reveal Signing: Signer Signs Commitment Keccak256(preimage) Instead Of Preimage Itself,
+// Commitment Is Hashed Under Separate Commitment DST "BN128_BLS_COMMITMENT_" || DST.
+
+import (
+	"math/big"
+)
+
+const commitmentDSTPrefix = "BN128_BLS_COMMITMENT_"
+
+// Returns Keccak256(preimage), Commitment Expected By SignCommitment, Same As Solidity keccak256(preimage).
+func MessageCommitment(preimage []byte) [32]byte {
+	return keccak256(preimage)
+}
+
+// Signs `commitment`, Normally MessageCommitment Of Preimage Which Is Revealed To Verifier Later.
+func (bls *BLS) SignCommitment(keyPair *KeyPair, commitment [32]byte) ([3]*big.Int, error) {
+	return bls.signForPurpose(keyPair, commitmentDSTPrefix, commitment[:])
+}
+
+// Verifies Signature Produced By SignCommitment Before Preimage Is Known.
+func (bls *BLS) VerifyCommitment(signature [3]*big.Int, signerPubKey [3][2]*big.Int, commitment [32]byte) (bool, error) {
+	return bls.verifyForPurpose(signature, signerPubKey, commitmentDSTPrefix, commitment[:])
+}
+
+// Verifies Signature Produced By SignCommitment Against Revealed `preimage`.
+func (bls *BLS) VerifyCommitmentPreimage(signature [3]*big.Int, signerPubKey [3][2]*big.Int, preimage []byte) (bool, error) {
+	return bls.VerifyCommitment(signature, signerPubKey, MessageCommitment(preimage))
+}
@@ -0,0 +1,37 @@
+package bn128_bls
+
+import (
+	"testing"
+
+	"golang.org/x/crypto/sha3"
+)
+
+func TestSignCommitment(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(tempMessage)
+	var commitment [32]byte
+	hasher.Sum(commitment[:0])
+	if MessageCommitment(tempMessage) != commitment {
+		t.Fatal("expected commitment to be keccak256 of preimage")
+	}
+
+	signature, err := bls.SignCommitment(keyPair, commitment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := bls.VerifyCommitmentPreimage(signature, keyPair.PubKey, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected correct preimage to verify, ok: %v, err: %v", ok, err)
+	}
+	if ok, _ := bls.VerifyCommitmentPreimage(signature, keyPair.PubKey, tempMessages[0]); ok {
+		t.Fatal("expected incorrect preimage to fail")
+	}
+	if ok, _ := bls.VerifyBytes(signature, keyPair.PubKey, commitment[:]); ok {
+		t.Fatal("expected commitment signature to differ from plain signature over commitment bytes")
+	}
+	plain, _ := bls.SignBytes(keyPair, append([]byte("BN128_BLS_COMMITMENT_"), commitment[:]...))
+	if ok, _ := bls.VerifyCommitment(plain, keyPair.PubKey, commitment); ok {
+		t.Fatal("expected plain signature over prefixed commitment not to verify as commitment signature")
+	}
+}
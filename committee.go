@@ -0,0 +1,159 @@
+package bn128_bls
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	bn128PKG "github.com/arnaucube/go-snark/bn128"
+)
+
+var ErrNotCommitteeMember = errors.New("pubKey is not committee member")
+
+// Verifies Aggregate Signatures Of Fixed Committee, Members Are Validated And Aggregated Once In SetMembers
+// Instead Of On Every Verification As FastAggregateVerify Does. Miller Lines Of Aggregate PubKey Are Cached Too,
+// So Each Verification Only Evaluates Lines At Message And Signature Points. Safe For Concurrent Use.
+type CommitteeVerifier struct {
+	bls            *BLS
+	mu             sync.RWMutex
+	members        [][3][2]*big.Int
+	aggPubKeyG2    [3][2]*big.Int
+	aggPubKeyLines bn128PKG.AteG2Precomp
+	id             [32]byte
+}
+
+func (bls *BLS) NewCommitteeVerifier(pubKeysG2 [][3][2]*big.Int) (*CommitteeVerifier, error) {
+	committeeVerifier := &CommitteeVerifier{bls: bls}
+	if err := committeeVerifier.SetMembers(pubKeysG2); err != nil {
+		return nil, err
+	}
+	return committeeVerifier, nil
+}
+
+// Replaces Committee Members And Rebuilds Cached Aggregate PubKey And Its Miller Lines, Previous State Is Kept If New Members Are Invalid.
+func (cv *CommitteeVerifier) SetMembers(pubKeysG2 [][3][2]*big.Int) error {
+	for i, pubKey := range pubKeysG2 {
+		if err := cv.bls.ValidatePubKey(pubKey); err != nil {
+			return fmt.Errorf("invalid pubKey at index %d: %w", i, err)
+		}
+	}
+	aggPubKeyG2, err := cv.bls.aggregatePubKeysG2(pubKeysG2)
+	if err != nil {
+		return fmt.Errorf("failed to aggregate pubKeys: %v", err)
+	}
+	var aggPubKeyLines bn128PKG.AteG2Precomp
+	if !cv.bls.bn128.G2.IsZero(aggPubKeyG2) {
+		aggPubKeyLines = cv.bls.precomputeG2Lines(aggPubKeyG2)
+	}
+	cv.mu.Lock()
+	defer cv.mu.Unlock()
+	cv.members = make([][3][2]*big.Int, len(pubKeysG2))
+	for i, pubKey := range pubKeysG2 {
+		cv.members[i] = CloneG2(pubKey)
+	}
+	cv.aggPubKeyG2 = aggPubKeyG2
+	cv.aggPubKeyLines = aggPubKeyLines
+	cv.id = cv.bls.CommitteeID(pubKeysG2)
+	return nil
+}
+
+func (cv *CommitteeVerifier) Members() [][3][2]*big.Int {
+	cv.mu.RLock()
+	defer cv.mu.RUnlock()
+	members := make([][3][2]*big.Int, len(cv.members))
+	for i, member := range cv.members {
+		members[i] = CloneG2(member)
+	}
+	return members
+}
+
+// Returns CommitteeID Of Current Members.
+func (cv *CommitteeVerifier) ID() [32]byte {
+	cv.mu.RLock()
+	defer cv.mu.RUnlock()
+	return cv.id
+}
+
+func (cv *CommitteeVerifier) AggregatePubKey() [3][2]*big.Int {
+	cv.mu.RLock()
+	defer cv.mu.RUnlock()
+	return CloneG2(cv.aggPubKeyG2)
+}
+
+// Verifies `aggSig` Over `message` Signed By Whole Committee.
+func (cv *CommitteeVerifier) Verify(aggSig [3]*big.Int, message []byte) (bool, error) {
+	_, ok, err := cv.verifyWithID(aggSig, message)
+	return ok, err
+}
+
+// Same As Verify, Also Returns ID Of Members Verification Ran Against, Taken Under Same Lock As Their Miller Lines,
+// So Result Can't Be Attributed To Committee Set By Concurrent SetMembers.
+func (cv *CommitteeVerifier) verifyWithID(aggSig [3]*big.Int, message []byte) ([32]byte, bool, error) {
+	if err := cv.bls.ValidateSignature(aggSig); err != nil {
+		return [32]byte{}, false, fmt.Errorf("invalid aggSig: %w", err)
+	}
+	messageG1 := cv.bls.HashToG1(message)
+	cv.mu.RLock()
+	id, aggPubKeyLines := cv.id, cv.aggPubKeyLines
+	cv.mu.RUnlock()
+	return id, cv.bls.pairingCheckLines(
+		[][3]*big.Int{messageG1, cv.bls.bn128.G1.Neg(aggSig)},
+		[]bn128PKG.AteG2Precomp{aggPubKeyLines, cv.bls.g2GeneratorLinesTable()},
+	), nil
+}
+
+// Validated Committee Indexed By Sorted PubKeyToBytes Encodings, Membership Is Checked Using Binary Search.
+// Read-Only After Construction, So Safe For Concurrent Use.
+type Committee struct {
+	bls     *BLS
+	members [][]byte
+}
+
+func (bls *BLS) NewCommittee(pubKeysG2 [][3][2]*big.Int) (*Committee, error) {
+	members := make([][]byte, len(pubKeysG2))
+	for i, pubKey := range pubKeysG2 {
+		if err := bls.ValidatePubKey(pubKey); err != nil {
+			return nil, fmt.Errorf("invalid pubKey at index %d: %w", i, err)
+		}
+		members[i] = bls.PubKeyToBytes(pubKey)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return bytes.Compare(members[i], members[j]) < 0
+	})
+	for i := 1; i < len(members); i++ {
+		if bytes.Equal(members[i-1], members[i]) {
+			return nil, fmt.Errorf("duplicate committee member %x", members[i])
+		}
+	}
+	return &Committee{bls: bls, members: members}, nil
+}
+
+func (committee *Committee) Len() int {
+	return len(committee.members)
+}
+
+func (committee *Committee) Contains(pubKeyG2 [3][2]*big.Int) bool {
+	if _, err := committee.bls.NormalizePointG2(pubKeyG2, false); err != nil {
+		return false
+	}
+	encoded := committee.bls.PubKeyToBytes(pubKeyG2)
+	i := sort.Search(len(committee.members), func(i int) bool {
+		return bytes.Compare(committee.members[i], encoded) >= 0
+	})
+	return i < len(committee.members) && bytes.Equal(committee.members[i], encoded)
+}
+
+// Verifies `signature` Over `message` Only If `pubKeyG2` Is Committee Member, Returning ErrNotCommitteeMember Otherwise,
+// So Valid Signature Of Outsider Is Never Accepted.
+func (committee *Committee) VerifyMember(signature [3]*big.Int, pubKeyG2 [3][2]*big.Int, message []byte) (bool, error) {
+	if !committee.Contains(pubKeyG2) {
+		return false, ErrNotCommitteeMember
+	}
+	if err := committee.bls.ValidateSignature(signature); err != nil {
+		return false, fmt.Errorf("invalid signature: %w", err)
+	}
+	return committee.bls.verifyPoint(signature, pubKeyG2, committee.bls.HashToG1(message)), nil
+}
@@ -0,0 +1,141 @@
+package bn128_bls
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestCommitteeVerifier(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	aggSig, _ := bls.AggregateSignatures(signAll(t, keyPairs, tempMessage))
+
+	committeeVerifier, err := bls.NewCommitteeVerifier(pubKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := committeeVerifier.Verify(aggSig, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected committee signature to verify, ok: %v, err: %v", ok, err)
+	}
+
+	if err := committeeVerifier.SetMembers(pubKeys[:3]); err != nil {
+		t.Fatal(err)
+	}
+	if len(committeeVerifier.Members()) != 3 {
+		t.Fatal("members were not replaced")
+	}
+	ok, err = committeeVerifier.Verify(aggSig, tempMessage)
+	if err != nil || ok {
+		t.Fatalf("expected signature of old committee to fail, ok: %v, err: %v", ok, err)
+	}
+
+	previousAggPubKey := committeeVerifier.AggregatePubKey()
+	if err := committeeVerifier.SetMembers(append(pubKeys, randomTwistPoint(t))); err == nil {
+		t.Fatal("expected error for off subgroup member")
+	}
+	if !bls.bn128.G2.Equal(committeeVerifier.AggregatePubKey(), previousAggPubKey) {
+		t.Fatal("aggregate pubKey changed after rejected SetMembers")
+	}
+}
+
+func TestCommitteeVerifierCachedLines(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	committeeVerifier, err := bls.NewCommitteeVerifier(pubKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggSig, _ := bls.AggregateSignatures(signAll(t, keyPairs, tempMessages[0]))
+	for i, message := range tempMessages[:2] {
+		expected, err := bls.FastAggregateVerify(aggSig, pubKeys, message)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ok, err := committeeVerifier.Verify(aggSig, message)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != expected || ok != (i == 0) {
+			t.Fatalf("message %d: cached lines gave %v, FastAggregateVerify gave %v", i, ok, expected)
+		}
+	}
+
+	// Lines Must Follow Aggregate Once Members Change.
+	if err := committeeVerifier.SetMembers(pubKeys[1:]); err != nil {
+		t.Fatal(err)
+	}
+	aggSig, _ = bls.AggregateSignatures(signAll(t, keyPairs[1:], tempMessages[0]))
+	if ok, err := committeeVerifier.Verify(aggSig, tempMessages[0]); err != nil || !ok {
+		t.Fatalf("expected signature of new committee to verify, ok: %v, err: %v", ok, err)
+	}
+}
+
+// Many Blocks Signed By Same Committee, Each Over Distinct Message.
+func BenchmarkCommitteeVerifierBlocks(b *testing.B) {
+	const blocks = 16
+	keyPairs, pubKeys := tempCommittee(b)
+	committeeVerifier, _ := bls.NewCommitteeVerifier(pubKeys)
+	messages := make([][]byte, blocks)
+	aggSigs := make([][3]*big.Int, blocks)
+	for i := range messages {
+		messages[i] = []byte(fmt.Sprintf("block %d", i))
+		aggSigs[i], _ = bls.AggregateSignatures(signAll(b, keyPairs, messages[i]))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		committeeVerifier.Verify(aggSigs[i%blocks], messages[i%blocks])
+	}
+}
+
+func BenchmarkCommitteeVerifier(b *testing.B) {
+	keyPairs, pubKeys := tempCommittee(b)
+	aggSig, _ := bls.AggregateSignatures(signAll(b, keyPairs, tempMessage))
+	committeeVerifier, _ := bls.NewCommitteeVerifier(pubKeys)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		committeeVerifier.Verify(aggSig, tempMessage)
+	}
+}
+
+func BenchmarkFastAggregateVerify(b *testing.B) {
+	keyPairs, pubKeys := tempCommittee(b)
+	aggSig, _ := bls.AggregateSignatures(signAll(b, keyPairs, tempMessage))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bls.FastAggregateVerify(aggSig, pubKeys, tempMessage)
+	}
+}
+
+func TestCommitteeMembership(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	committee, err := bls.NewCommittee(pubKeys[:3])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if committee.Len() != 3 {
+		t.Fatalf("expected 3 members, got %d", committee.Len())
+	}
+	for i, pubKey := range pubKeys[:3] {
+		if !committee.Contains(pubKey) {
+			t.Fatalf("expected member %d to be found", i)
+		}
+	}
+	if committee.Contains(pubKeys[3]) {
+		t.Fatal("expected outsider not to be found")
+	}
+
+	signature, _ := bls.SignBytes(keyPairs[1], tempMessage)
+	ok, err := committee.VerifyMember(signature, pubKeys[1], tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected member signature to verify, ok: %v, err: %v", ok, err)
+	}
+	outsiderSignature, _ := bls.SignBytes(keyPairs[3], tempMessage)
+	if _, err := committee.VerifyMember(outsiderSignature, pubKeys[3], tempMessage); !errors.Is(err, ErrNotCommitteeMember) {
+		t.Fatalf("expected ErrNotCommitteeMember, got: %v", err)
+	}
+
+	if _, err := bls.NewCommittee([][3][2]*big.Int{pubKeys[0], pubKeys[0]}); err == nil {
+		t.Fatal("expected error for duplicate member")
+	}
+}
@@ -0,0 +1,115 @@
+package bn128_bls
+
+// Compressed G2 PubKeys, 64 Bytes: x_im || x_re (Same Order As PubKeyToBytes) With Flags In Two Top Bits Of First Byte,
+// Which Are Always Free Because Q < 2^254.
+//   - 0x80: Compression Marker, Always Set.
+//   - 0x40: Set When y Is Larger Of {y, -y}, Comparing y_im First And y_re Only When y_im Is Zero.
+// Point At Infinity Has No Compressed Form Since It Is Never Valid PubKey.
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const (
+	CompressedPubKeySize = 2 * coordinateSize
+
+	compressedFlag = 0x80
+	largerYFlag    = 0x40
+)
+
+// Compresses `pubKey` Into 64 Bytes.
+func (bls *BLS) CompressG2(pubKey [3][2]*big.Int) ([CompressedPubKeySize]byte, error) {
+	var data [CompressedPubKeySize]byte
+	if err := bls.ValidatePubKey(pubKey); err != nil {
+		return data, fmt.Errorf("invalid pubKey: %w", err)
+	}
+	affine := bls.bn128.G2.Affine(pubKey)
+	xIm, xRe := ToFixed32(affine[0][1]), ToFixed32(affine[0][0])
+	copy(data[:coordinateSize], xIm[:])
+	copy(data[coordinateSize:], xRe[:])
+	data[0] |= compressedFlag
+	if bls.isLargerFq2(affine[1]) {
+		data[0] |= largerYFlag
+	}
+	return data, nil
+}
+
+// Decompresses PubKey Produced By CompressG2, Result Is Validated Using ValidatePubKey.
+func (bls *BLS) DecompressG2(data [CompressedPubKeySize]byte) ([3][2]*big.Int, error) {
+	if data[0]&compressedFlag == 0 {
+		return [3][2]*big.Int{}, fmt.Errorf("compression flag is not set")
+	}
+	largerY := data[0]&largerYFlag != 0
+	data[0] &^= compressedFlag | largerYFlag
+
+	xIm, err := bls.coordinateFromBytes(data[:coordinateSize])
+	if err != nil {
+		return [3][2]*big.Int{}, err
+	}
+	xRe, err := bls.coordinateFromBytes(data[coordinateSize:])
+	if err != nil {
+		return [3][2]*big.Int{}, err
+	}
+	fq2 := bls.bn128.Fq2
+	x := [2]*big.Int{xRe, xIm}
+	y, ok := bls.sqrtFq2(fq2.Add(fq2.Mul(fq2.Square(x), x), bls.bn128.TwistCoefB))
+	if !ok {
+		return [3][2]*big.Int{}, fmt.Errorf("invalid compressed pubKey: %w", ErrNotOnCurve)
+	}
+	if bls.isLargerFq2(y) != largerY {
+		y = fq2.Affine(fq2.Neg(y))
+	}
+	pubKey := [3][2]*big.Int{x, y, fq2.One()}
+	if err := bls.ValidatePubKey(pubKey); err != nil {
+		return [3][2]*big.Int{}, fmt.Errorf("invalid compressed pubKey: %w", err)
+	}
+	return pubKey, nil
+}
+
+// Decompresses `compressedPubKey` And Verifies `signature` Over `message` Using VerifyBytes.
+func (bls *BLS) VerifyCompressedG2Key(signature [3]*big.Int, compressedPubKey [CompressedPubKeySize]byte, message []byte) (bool, error) {
+	pubKey, err := bls.DecompressG2(compressedPubKey)
+	if err != nil {
+		return false, err
+	}
+	return bls.VerifyBytes(signature, pubKey, message)
+}
+
+func (bls *BLS) isLargerFq2(a [2]*big.Int) bool {
+	halfQ := new(big.Int).Rsh(bls.bn128.Q, 1)
+	a = bls.bn128.Fq2.Affine(a)
+	if a[1].Sign() != 0 {
+		return a[1].Cmp(halfQ) > 0
+	}
+	return a[0].Cmp(halfQ) > 0
+}
+
+// Square Root In Fq2 For Q ≡ 3 (mod 4), Algorithm 9 Of https://eprint.iacr.org/2012/685.
+func (bls *BLS) sqrtFq2(a [2]*big.Int) ([2]*big.Int, bool) {
+	fq2 := bls.bn128.Fq2
+	q := bls.bn128.Q
+	a1 := bls.expFq2(a, new(big.Int).Rsh(new(big.Int).Sub(q, big.NewInt(3)), 2))
+	alpha := fq2.Mul(fq2.Square(a1), a)
+	x0 := fq2.Mul(a1, a)
+	var x [2]*big.Int
+	if fq2.Equal(alpha, fq2.Neg(fq2.One())) {
+		x = fq2.Mul([2]*big.Int{big.NewInt(0), big.NewInt(1)}, x0)
+	} else {
+		b := bls.expFq2(fq2.Add(fq2.One(), alpha), new(big.Int).Rsh(new(big.Int).Sub(q, big.NewInt(1)), 1))
+		x = fq2.Mul(b, x0)
+	}
+	return fq2.Affine(x), fq2.Equal(fq2.Square(x), a)
+}
+
+func (bls *BLS) expFq2(base [2]*big.Int, e *big.Int) [2]*big.Int {
+	fq2 := bls.bn128.Fq2
+	res := fq2.One()
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		res = fq2.Square(res)
+		if e.Bit(i) == 1 {
+			res = fq2.Mul(res, base)
+		}
+	}
+	return res
+}
@@ -0,0 +1,53 @@
+package bn128_bls
+
+import (
+	"testing"
+)
+
+func TestCompressG2(t *testing.T) {
+	_, pubKeys := tempCommittee(t)
+	for _, pubKey := range append(pubKeys, bls.bn128.G2.G) {
+		compressed, err := bls.CompressG2(pubKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decompressed, err := bls.DecompressG2(compressed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bls.bn128.G2.Equal(decompressed, pubKey) {
+			t.Fatal("decompressed pubKey does not match original")
+		}
+
+		flipped := compressed
+		flipped[0] ^= largerYFlag
+		negated, err := bls.DecompressG2(flipped)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bls.bn128.G2.Equal(negated, bls.bn128.G2.Neg(pubKey)) {
+			t.Fatal("flipping y flag did not negate pubKey")
+		}
+	}
+
+	if _, err := bls.CompressG2(bls.bn128.G2.Zero()); err == nil {
+		t.Fatal("expected error compressing point at infinity")
+	}
+	var uncompressed [CompressedPubKeySize]byte
+	if _, err := bls.DecompressG2(uncompressed); err == nil {
+		t.Fatal("expected error for missing compression flag")
+	}
+}
+
+func TestVerifyCompressedG2Key(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+	compressed, err := bls.CompressG2(keyPair.PubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := bls.VerifyCompressedG2Key(signature, compressed, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected signature to verify against compressed pubKey, ok: %v, err: %v", ok, err)
+	}
+}
@@ -0,0 +1,77 @@
+package bn128_bls
+
+// Canonical Evidence Format For Challenging Signature On-Chain (Slashing/Disputes).
+// Layout: Version (1 Byte) || ConfigFingerprint (32) || Signature (64) || PubKey (128, EIP-197 Order) || uint32 Message Length || Message.
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+const (
+	DisputeBundleVersion    = 1
+	disputeBundleHeaderSize = 1 + 32 + SignatureSize + PubKeySize + 4
+)
+
+// Packs `signature`, `signerPubKey` And `message` Into Deterministic Versioned Bundle, Inputs Are Validated First.
+func (bls *BLS) DisputeBundle(signature [3]*big.Int, signerPubKey [3][2]*big.Int, message []byte) ([]byte, error) {
+	if err := bls.ValidateSignature(signature); err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	if err := bls.ValidatePubKey(signerPubKey); err != nil {
+		return nil, fmt.Errorf("invalid signerPubKey: %w", err)
+	}
+	if uint64(len(message)) > 0xffffffff {
+		return nil, fmt.Errorf("message is too long, got %d bytes", len(message))
+	}
+	configFingerprint, err := bls.ConfigFingerprint()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fingerprint config: %w", err)
+	}
+	data := make([]byte, 0, disputeBundleHeaderSize+len(message))
+	data = append(data, DisputeBundleVersion)
+	data = append(data, configFingerprint[:]...)
+	data = append(data, bls.SignatureToBytes(signature)...)
+	data = append(data, bls.PubKeyToBytes(signerPubKey)...)
+	data = binary.BigEndian.AppendUint32(data, uint32(len(message)))
+	data = append(data, message...)
+	return data, nil
+}
+
+// Parses Bundle Produced By DisputeBundle And Verifies It, Bundle Made Under Different Hashing Configuration
+// (DST, Hash To Curve Method Or Field Mapping) Is Rejected With Error.
+func (bls *BLS) VerifyDisputeBundle(data []byte) (bool, error) {
+	if len(data) < disputeBundleHeaderSize {
+		return false, fmt.Errorf("dispute bundle is too short")
+	}
+	if data[0] != DisputeBundleVersion {
+		return false, fmt.Errorf("unsupported dispute bundle version %d", data[0])
+	}
+	offset := 1
+	configFingerprint, err := bls.ConfigFingerprint()
+	if err != nil {
+		return false, fmt.Errorf("failed to fingerprint config: %w", err)
+	}
+	if !bytes.Equal(data[offset:offset+32], configFingerprint[:]) {
+		return false, fmt.Errorf("dispute bundle config fingerprint does not match configured hashing")
+	}
+	offset += 32
+	signature, err := bls.SignatureFromBytes(data[offset : offset+SignatureSize])
+	if err != nil {
+		return false, err
+	}
+	offset += SignatureSize
+	signerPubKey, err := bls.pubKeyFromBytes(data[offset : offset+PubKeySize])
+	if err != nil {
+		return false, err
+	}
+	offset += PubKeySize
+	messageLength := binary.BigEndian.Uint32(data[offset:])
+	offset += 4
+	if uint64(len(data)-offset) != uint64(messageLength) {
+		return false, fmt.Errorf("invalid dispute bundle length %d, expected %d", len(data), uint64(offset)+uint64(messageLength))
+	}
+	return bls.VerifyBytes(signature, signerPubKey, data[offset:])
+}
@@ -0,0 +1,60 @@
+package bn128_bls
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDisputeBundle(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+	bundle, err := bls.DisputeBundle(signature, keyPair.PubKey, tempMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, _ := bls.DisputeBundle(rescaleG1(signature, 5), keyPair.PubKey, tempMessage)
+	if !bytes.Equal(bundle, again) {
+		t.Fatal("expected bundle to be independent of jacobian representation")
+	}
+
+	ok, err := bls.VerifyDisputeBundle(bundle)
+	if err != nil || !ok {
+		t.Fatalf("expected bundle to verify, ok: %v, err: %v", ok, err)
+	}
+
+	tampered := append([]byte{}, bundle...)
+	tampered[len(tampered)-1] ^= 1
+	ok, err = bls.VerifyDisputeBundle(tampered)
+	if err != nil || ok {
+		t.Fatalf("expected tampered message to fail, ok: %v, err: %v", ok, err)
+	}
+
+	for _, corrupt := range [][]byte{
+		append([]byte{2}, bundle[1:]...),
+		append(append([]byte{}, bundle...), 0),
+		bundle[:len(bundle)-1],
+		bundle[:10],
+	} {
+		if _, err := bls.VerifyDisputeBundle(corrupt); err == nil {
+			t.Fatal("expected error for malformed bundle")
+		}
+	}
+
+	otherBls := NewBls()
+	otherBls.SetDST([]byte("OTHER_DST_"))
+	if _, err := otherBls.VerifyDisputeBundle(bundle); err == nil {
+		t.Fatal("expected error for dst mismatch")
+	}
+	keySizeBls := NewBls()
+	keySizeBls.SetPrivateKeySize(128)
+	if ok, err := keySizeBls.VerifyDisputeBundle(bundle); err != nil || !ok {
+		t.Fatalf("expected bundle to verify under different private key size, ok: %v, err: %v", ok, err)
+	}
+	svdwBls := NewBls()
+	if err := svdwBls.SetHashToCurve(HashToCurveSVDW); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svdwBls.VerifyDisputeBundle(bundle); err == nil {
+		t.Fatal("expected error for hash to curve mismatch")
+	}
+}
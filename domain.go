@@ -0,0 +1,27 @@
+package bn128_bls
+
+// ETH2-Style Domain Separation, Domain Mixes Domain Type, Fork Version And Genesis Validators Root,
+// So Signatures Made For One Fork Or Chain Do Not Verify On Another.
+
+import (
+	"crypto/sha256"
+)
+
+// Returns domainType || ForkDataRoot[:28] Where ForkDataRoot = SHA256(forkVersion Padded To 32 Bytes || genesisValidatorsRoot),
+// Same As compute_domain Of Ethereum Consensus Specs.
+func ComputeDomain(domainType [4]byte, forkVersion [4]byte, genesisValidatorsRoot [32]byte) [32]byte {
+	forkData := make([]byte, 64)
+	copy(forkData, forkVersion[:])
+	copy(forkData[32:], genesisValidatorsRoot[:])
+	forkDataRoot := sha256.Sum256(forkData)
+
+	domain := [32]byte{}
+	copy(domain[:4], domainType[:])
+	copy(domain[4:], forkDataRoot[:28])
+	return domain
+}
+
+// Sets DST To DefaultDST || `domain`, Typically Result Of ComputeDomain, Subject To Same Locking As SetDST.
+func (bls *BLS) SetDomain(domain [32]byte) error {
+	return bls.SetDST(append([]byte(DefaultDST), domain[:]...))
+}
@@ -0,0 +1,43 @@
+package bn128_bls
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestComputeDomain(t *testing.T) {
+	// Mainnet DOMAIN_DEPOSIT With Genesis Fork Version And Zero Root.
+	depositDomain := ComputeDomain([4]byte{0x03, 0, 0, 0}, [4]byte{}, [32]byte{})
+	if hex.EncodeToString(depositDomain[:]) != "03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9" {
+		t.Fatalf("unexpected deposit domain %x", depositDomain)
+	}
+
+	domainType := [4]byte{0x07, 0, 0, 0}
+	genesisValidatorsRoot := keccak256([]byte("genesis"))
+	domainA := ComputeDomain(domainType, [4]byte{0, 0, 0, 1}, genesisValidatorsRoot)
+	domainB := ComputeDomain(domainType, [4]byte{0, 0, 0, 2}, genesisValidatorsRoot)
+	if domainA == domainB {
+		t.Fatal("expected fork versions to yield different domains")
+	}
+	if [4]byte(domainA[:4]) != domainType {
+		t.Fatal("expected domain to start with domain type")
+	}
+
+	blsA, blsB := NewBls(), NewBls()
+	if err := blsA.SetDomain(domainA); err != nil {
+		t.Fatal(err)
+	}
+	if err := blsB.SetDomain(domainB); err != nil {
+		t.Fatal(err)
+	}
+	keyPair, _ := blsA.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := blsA.SignBytes(keyPair, tempMessage)
+	ok, err := blsA.VerifyBytes(signature, keyPair.PubKey, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected signature to verify under its fork, ok: %v, err: %v", ok, err)
+	}
+	ok, err = blsB.VerifyBytes(signature, keyPair.PubKey, tempMessage)
+	if err != nil || ok {
+		t.Fatalf("expected signature not to verify under other fork, ok: %v, err: %v", ok, err)
+	}
+}
@@ -0,0 +1,328 @@
+package bn128_bls
+
+// Byte Layouts Shared With Solidity Verifiers, All Integers Are Big-Endian And Every Coordinate Takes 32 Bytes.
+// Every Byte Output Of This Package Is Fixed-Width: Coordinates Are Written Using ToFixed32, So Leading Zeros Are Kept.
+// Signature (G1): x || y, 64 Bytes.
+// PubKey (G2): x_im || x_re || y_im || y_re, 128 Bytes, Which Is Order Expected By EIP-197 Pairing Precompile.
+// Note: bn128 Package Stores Fq2 Elements As [re, im] (Same As ParsePubKey Output), So Halves Are Swapped While Encoding.
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+const (
+	coordinateSize = 32
+	SignatureSize  = 2 * coordinateSize
+	PubKeySize     = 4 * coordinateSize
+	pairingSize    = SignatureSize + PubKeySize
+)
+
+// Encodes Non-Negative `x` Below 2^256 As 32 Byte Big-Endian Integer Left-Padded With Zeros, Panics Otherwise.
+func ToFixed32(x *big.Int) [32]byte {
+	if x.Sign() < 0 || x.BitLen() > 8*coordinateSize {
+		panic(fmt.Sprintf("bn128_bls: %v does not fit into 32 bytes", x))
+	}
+	fixed := [32]byte{}
+	x.FillBytes(fixed[:])
+	return fixed
+}
+
+// Encodes `signature` As 64 Byte Affine x || y.
+func (bls *BLS) SignatureToBytes(signature [3]*big.Int) []byte {
+	affine := bls.ParseSignature(signature)
+	x, y := ToFixed32(affine[0]), ToFixed32(affine[1])
+	return append(x[:], y[:]...)
+}
+
+// Returns Affine Form Of `signature` With z = 1, So Different Jacobian Representations Of Same Point Become Identical.
+func (bls *BLS) CanonicalizeSignature(signature [3]*big.Int) [3]*big.Int {
+	return bls.NewG1(bls.ParseSignature(signature))
+}
+
+// Decodes 64 Byte Affine Signature, Result Is Validated Using ValidateSignature.
+func (bls *BLS) SignatureFromBytes(data []byte) ([3]*big.Int, error) {
+	if len(data) != SignatureSize {
+		return [3]*big.Int{}, fmt.Errorf("invalid signature length %d, expected %d", len(data), SignatureSize)
+	}
+	x, err := bls.coordinateFromBytes(data[:coordinateSize])
+	if err != nil {
+		return [3]*big.Int{}, err
+	}
+	y, err := bls.coordinateFromBytes(data[coordinateSize:])
+	if err != nil {
+		return [3]*big.Int{}, err
+	}
+	signature := bls.NewG1([2]*big.Int{x, y})
+	if err := bls.ValidateSignature(signature); err != nil {
+		return [3]*big.Int{}, fmt.Errorf("invalid signature: %w", err)
+	}
+	return signature, nil
+}
+
+// Encodes `pubKey` As 128 Byte Affine Point In EIP-197 Order.
+func (bls *BLS) PubKeyToBytes(pubKey [3][2]*big.Int) []byte {
+	affine := bls.bn128.G2.Affine(pubKey)
+	data := make([]byte, 0, PubKeySize)
+	for _, coordinate := range []*big.Int{affine[0][1], affine[0][0], affine[1][1], affine[1][0]} {
+		fixed := ToFixed32(coordinate)
+		data = append(data, fixed[:]...)
+	}
+	return data
+}
+
+// Decodes 128 Byte Affine PubKey In EIP-197 Order (e.g., Emitted By Contract Event), Result Is Validated Using ValidatePubKey.
+func (bls *BLS) PubKeyFromBytes(data []byte) ([3][2]*big.Int, error) {
+	pubKey, err := bls.pubKeyFromBytes(data)
+	if err != nil {
+		return [3][2]*big.Int{}, err
+	}
+	if err := bls.ValidatePubKey(pubKey); err != nil {
+		return [3][2]*big.Int{}, fmt.Errorf("invalid pubKey: %w", err)
+	}
+	return pubKey, nil
+}
+
+// Verifies `signature` Over `message` Under PubKey Given As 128 Byte EIP-197 Ordered Blob.
+func (bls *BLS) VerifyUncompressedPubKey(signature [3]*big.Int, pubKeyBytes []byte, message []byte) (bool, error) {
+	pubKey, err := bls.PubKeyFromBytes(pubKeyBytes)
+	if err != nil {
+		return false, err
+	}
+	if err := bls.ValidateSignature(signature); err != nil {
+		return false, fmt.Errorf("invalid signature: %w", err)
+	}
+	return bls.verifyPoint(signature, pubKey, bls.HashToG1(message)), nil
+}
+
+// Decodes 128 Byte Affine PubKey In EIP-197 Order, Only Coordinate Range Is Checked.
+func (bls *BLS) pubKeyFromBytes(data []byte) ([3][2]*big.Int, error) {
+	if len(data) != PubKeySize {
+		return [3][2]*big.Int{}, fmt.Errorf("invalid pubKey length %d, expected %d", len(data), PubKeySize)
+	}
+	coordinates := [4]*big.Int{}
+	for i := range coordinates {
+		coordinate, err := bls.coordinateFromBytes(data[i*coordinateSize : (i+1)*coordinateSize])
+		if err != nil {
+			return [3][2]*big.Int{}, err
+		}
+		coordinates[i] = coordinate
+	}
+	return bls.NewG2([2][2]*big.Int{
+		{coordinates[1], coordinates[0]},
+		{coordinates[3], coordinates[2]},
+	}), nil
+}
+
+// Returns Keccak256 Of PubKeyToBytes, Canonical 32 Byte Identifier Of PubKey Independent Of Its Jacobian Representation.
+func (bls *BLS) PubKeyIdentifier(pubKey [3][2]*big.Int) [32]byte {
+	return keccak256(bls.PubKeyToBytes(pubKey))
+}
+
+// Returns Keccak256 Of SignatureToBytes, Compact Identifier For Deduplicating Signatures Independent Of Jacobian Representation.
+func (bls *BLS) SignatureDigest(signature [3]*big.Int) [32]byte {
+	return keccak256(bls.SignatureToBytes(signature))
+}
+
+// Builds Input For EIP-197 Pairing Precompile (Address 0x08) Which Returns 1 Iff Signature Is Valid.
+// Layout: signature || -G2 || H(message) || pubKey, Checking e(signature, -G2) * e(H(message), pubKey) == 1.
+func (bls *BLS) VerificationCalldata(signature [3]*big.Int, pubKey [3][2]*big.Int, message []byte) ([]byte, error) {
+	if err := bls.ValidateSignature(signature); err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	if err := bls.ValidatePubKey(pubKey); err != nil {
+		return nil, fmt.Errorf("invalid pubKey: %w", err)
+	}
+	return bls.verificationCalldata(signature, pubKey, bls.HashToG1(message)), nil
+}
+
+func (bls *BLS) verificationCalldata(signature [3]*big.Int, pubKey [3][2]*big.Int, messageG1 [3]*big.Int) []byte {
+	data := make([]byte, 0, 2*pairingSize)
+	data = append(data, bls.SignatureToBytes(signature)...)
+	data = append(data, bls.PubKeyToBytes(bls.bn128.G2.Neg(bls.bn128.G2.G))...)
+	data = append(data, bls.SignatureToBytes(messageG1)...)
+	data = append(data, bls.PubKeyToBytes(pubKey)...)
+	return data
+}
+
+// Same Layout As VerificationCalldata With `pubKeysG2` Aggregated Off-Chain, So It Stays At Two Pairs.
+func (bls *BLS) FastAggregateVerificationCalldata(aggSig [3]*big.Int, pubKeysG2 [][3][2]*big.Int, message []byte) ([]byte, error) {
+	for i, pubKey := range pubKeysG2 {
+		if err := bls.ValidatePubKey(pubKey); err != nil {
+			return nil, fmt.Errorf("invalid pubKey at index %d: %w", i, err)
+		}
+	}
+	aggPubKeyG2, err := bls.aggregatePubKeysG2(pubKeysG2)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate pubKeys: %v", err)
+	}
+	return bls.VerificationCalldata(aggSig, aggPubKeyG2, message)
+}
+
+// Builds Precompile Input For AggregateVerify Over Distinct Messages, One Pair Per Message Plus One For Signature:
+// aggSig || -G2 || H(messages[0]) || pubKeysG2[0] || ... || H(messages[n-1]) || pubKeysG2[n-1].
+func (bls *BLS) AggregateVerificationCalldata(aggSig [3]*big.Int, pubKeysG2 [][3][2]*big.Int, messages [][]byte) ([]byte, error) {
+	if len(messages) != len(pubKeysG2) {
+		return nil, fmt.Errorf("%d messages passed for %d pubKeys", len(messages), len(pubKeysG2))
+	}
+	if len(messages) < 1 {
+		return nil, fmt.Errorf("no message have been passed")
+	}
+	if err := bls.ValidateSignature(aggSig); err != nil {
+		return nil, fmt.Errorf("invalid aggSig: %w", err)
+	}
+	data := make([]byte, 0, (len(messages)+1)*pairingSize)
+	data = append(data, bls.SignatureToBytes(aggSig)...)
+	data = append(data, bls.PubKeyToBytes(bls.bn128.G2.Neg(bls.bn128.G2.G))...)
+	for i, pubKey := range pubKeysG2 {
+		if err := bls.ValidatePubKey(pubKey); err != nil {
+			return nil, fmt.Errorf("invalid pubKey at index %d: %w", i, err)
+		}
+		data = append(data, bls.SignatureToBytes(bls.HashToG1(messages[i]))...)
+		data = append(data, bls.PubKeyToBytes(pubKey)...)
+	}
+	return data, nil
+}
+
+// Returns Number Of Pairs In Precompile Input `calldata`, Which Dominates Gas Cost
+// (EIP-1108: 45000 + 34000 Per Pair), Single And Fast Aggregate Calldata Have 2 Pairs, Aggregate Calldata Has n+1.
+func OnChainPairingCount(calldata []byte) (int, error) {
+	if len(calldata) == 0 || len(calldata)%pairingSize != 0 {
+		return 0, fmt.Errorf("invalid calldata length %d, it must be positive multiple of %d", len(calldata), pairingSize)
+	}
+	return len(calldata) / pairingSize, nil
+}
+
+// Inverse Of VerificationCalldata, Locally Pre-Checks Exact Bytes That Will Be Submitted To Pairing Precompile.
+func (bls *BLS) VerifyFromCalldata(calldata []byte) (bool, error) {
+	if len(calldata) != 2*pairingSize {
+		return false, fmt.Errorf("invalid calldata length %d, expected %d", len(calldata), 2*pairingSize)
+	}
+	signature, err := bls.SignatureFromBytes(calldata[:SignatureSize])
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(calldata[SignatureSize:pairingSize], bls.PubKeyToBytes(bls.bn128.G2.Neg(bls.bn128.G2.G))) {
+		return false, fmt.Errorf("calldata does not contain negated G2 generator")
+	}
+	messageG1, err := bls.SignatureFromBytes(calldata[pairingSize : pairingSize+SignatureSize])
+	if err != nil {
+		return false, fmt.Errorf("invalid message point: %w", err)
+	}
+	pubKey, err := bls.PubKeyFromBytes(calldata[pairingSize+SignatureSize:])
+	if err != nil {
+		return false, err
+	}
+	return bls.verifyPoint(signature, pubKey, messageG1), nil
+}
+
+// Verifies Standard Base64 Encoded 64 Byte Signature, 128 Byte EIP-197 PubKey And Raw Message, For Web APIs.
+func (bls *BLS) VerifyBase64(signatureB64, pubKeyB64, messageB64 string) (bool, error) {
+	signatureBytes, err := base64.StdEncoding.DecodeString(signatureB64)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode signature: %v", err)
+	}
+	pubKeyBytes, err := base64.StdEncoding.DecodeString(pubKeyB64)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode pubKey: %v", err)
+	}
+	message, err := base64.StdEncoding.DecodeString(messageB64)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode message: %v", err)
+	}
+	signature, err := bls.SignatureFromBytes(signatureBytes)
+	if err != nil {
+		return false, err
+	}
+	pubKey, err := bls.pubKeyFromBytes(pubKeyBytes)
+	if err != nil {
+		return false, err
+	}
+	return bls.VerifyBytes(signature, pubKey, message)
+}
+
+// Verifies Signature And PubKey Encoded With Possibly Different Byte Orders, e.g. Signature From Ethereum And PubKey From Other Chain.
+// Order Applies Inside Each 32 Byte Coordinate, Coordinate Sequence Is Always Same As SignatureToBytes And PubKeyToBytes.
+// Only binary.BigEndian And binary.LittleEndian Are Accepted, Decoded Points Are Validated As In VerifyBytes.
+func (bls *BLS) VerifyMixedEndian(sigBytes []byte, sigOrder binary.ByteOrder, pubKeyBytes []byte, pubKeyOrder binary.ByteOrder, message []byte) (bool, error) {
+	sigBytes, err := coordinatesToBigEndian(sigBytes, sigOrder)
+	if err != nil {
+		return false, fmt.Errorf("invalid signature byte order: %v", err)
+	}
+	pubKeyBytes, err = coordinatesToBigEndian(pubKeyBytes, pubKeyOrder)
+	if err != nil {
+		return false, fmt.Errorf("invalid pubKey byte order: %v", err)
+	}
+	signature, err := bls.SignatureFromBytes(sigBytes)
+	if err != nil {
+		return false, err
+	}
+	pubKey, err := bls.PubKeyFromBytes(pubKeyBytes)
+	if err != nil {
+		return false, err
+	}
+	return bls.verifyPoint(signature, pubKey, bls.HashToG1(message)), nil
+}
+
+// Returns Copy Of `data` With Every 32 Byte Coordinate Converted From `order` To Big-Endian.
+func coordinatesToBigEndian(data []byte, order binary.ByteOrder) ([]byte, error) {
+	converted := append([]byte{}, data...)
+	switch order {
+	case binary.BigEndian:
+		return converted, nil
+	case binary.LittleEndian:
+	default:
+		return nil, fmt.Errorf("unsupported byte order %v", order)
+	}
+	for offset := 0; offset+coordinateSize <= len(converted); offset += coordinateSize {
+		coordinate := converted[offset : offset+coordinateSize]
+		for i, j := 0, len(coordinate)-1; i < j; i, j = i+1, j-1 {
+			coordinate[i], coordinate[j] = coordinate[j], coordinate[i]
+		}
+	}
+	return converted, nil
+}
+
+// Packs Aggregate Signature Together With Bitmap Of Its Contributors For On-Chain Submission.
+// Layout: uint16 Bitmap Length || Bitmap || 64 Byte Affine Signature.
+func (bls *BLS) PackAggregate(aggSig [3]*big.Int, bitmap []byte) ([]byte, error) {
+	if len(bitmap) > 0xffff {
+		return nil, fmt.Errorf("bitmap is too long, got %d bytes, max is %d", len(bitmap), 0xffff)
+	}
+	if err := bls.ValidateSignature(aggSig); err != nil {
+		return nil, fmt.Errorf("invalid aggSig: %w", err)
+	}
+	data := make([]byte, 2, 2+len(bitmap)+SignatureSize)
+	binary.BigEndian.PutUint16(data, uint16(len(bitmap)))
+	data = append(data, bitmap...)
+	data = append(data, bls.SignatureToBytes(aggSig)...)
+	return data, nil
+}
+
+// Reverses PackAggregate, Returning Aggregate Signature And Bitmap.
+func (bls *BLS) UnpackAggregate(data []byte) ([3]*big.Int, []byte, error) {
+	if len(data) < 2 {
+		return [3]*big.Int{}, nil, fmt.Errorf("packed aggregate is too short")
+	}
+	bitmapLength := int(binary.BigEndian.Uint16(data))
+	if len(data) != 2+bitmapLength+SignatureSize {
+		return [3]*big.Int{}, nil, fmt.Errorf("invalid packed aggregate length %d, expected %d", len(data), 2+bitmapLength+SignatureSize)
+	}
+	bitmap := append([]byte{}, data[2:2+bitmapLength]...)
+	aggSig, err := bls.SignatureFromBytes(data[2+bitmapLength:])
+	if err != nil {
+		return [3]*big.Int{}, nil, err
+	}
+	return aggSig, bitmap, nil
+}
+
+func (bls *BLS) coordinateFromBytes(data []byte) (*big.Int, error) {
+	coordinate := new(big.Int).SetBytes(data)
+	if coordinate.Cmp(bls.bn128.Q) >= 0 {
+		return nil, fmt.Errorf("coordinate is not reduced modulo q")
+	}
+	return coordinate, nil
+}
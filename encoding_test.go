@@ -0,0 +1,286 @@
+package bn128_bls
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+)
+
+const packedAggregateGolden = "00020b80" +
+	"256ad919916ee7f326d583cf7c6ef51c9503e276141652092296a8b2980603972" +
+	"af586f9ea343b901630cbd57534b5f4449834780f70b5b4a9d8b73278eb5147"
+
+func TestPackAggregate(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.GenerateSignature(keyPair, tempMessageX, tempMessageY)
+	bitmap := []byte{0x0b, 0x80}
+
+	packed, err := bls.PackAggregate(signature, bitmap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(packed) != packedAggregateGolden {
+		t.Fatalf("packed aggregate changed:\n got: %x\nwant: %s", packed, packedAggregateGolden)
+	}
+
+	unpackedSignature, unpackedBitmap, err := bls.UnpackAggregate(packed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bls.bn128.G1.Equal(unpackedSignature, signature) || !bytes.Equal(unpackedBitmap, bitmap) {
+		t.Fatal("unpacked aggregate does not match packed one")
+	}
+
+	if _, _, err := bls.UnpackAggregate(packed[:len(packed)-1]); err == nil {
+		t.Fatal("expected error for truncated packed aggregate")
+	}
+	tampered := append([]byte{}, packed...)
+	tampered[len(tampered)-1] ^= 1
+	if _, _, err := bls.UnpackAggregate(tampered); err == nil {
+		t.Fatal("expected error for off curve signature")
+	}
+}
+
+// Evaluates Pairing Precompile Success Condition For `input` Using Only This Library's Arithmetic.
+func pairingPrecompile(t *testing.T, input []byte) bool {
+	if len(input)%pairingSize != 0 {
+		t.Fatalf("invalid precompile input length %d", len(input))
+	}
+	word := func(offset int) *big.Int {
+		return new(big.Int).SetBytes(input[offset : offset+coordinateSize])
+	}
+	product := bls.bn128.Fq12.One()
+	for offset := 0; offset < len(input); offset += pairingSize {
+		g1 := bls.NewG1([2]*big.Int{word(offset), word(offset + 32)})
+		g2 := bls.NewG2([2][2]*big.Int{
+			{word(offset + 96), word(offset + 64)},
+			{word(offset + 160), word(offset + 128)},
+		})
+		product = bls.bn128.Fq12.Mul(product, bls.bn128.Pairing(g1, g2))
+	}
+	return bls.bn128.Fq12.Equal(product, bls.bn128.Fq12.One())
+}
+
+func TestEIP197PubKeyOrder(t *testing.T) {
+	// G2 Generator As Specified In EIP-197: x_im, x_re, y_im, y_re.
+	expected := "198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c2" +
+		"1800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed" +
+		"090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b" +
+		"12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa"
+	if hex.EncodeToString(bls.PubKeyToBytes(bls.bn128.G2.G)) != expected {
+		t.Fatalf("G2 generator encoding does not match EIP-197: %x", bls.PubKeyToBytes(bls.bn128.G2.G))
+	}
+}
+
+func TestEIP197VerificationCalldata(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/eip197_verification_calldata.hex")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := hex.DecodeString(strings.TrimSpace(string(fixture)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.GenerateSignature(keyPair, tempMessageX, tempMessageY)
+	messageX, _ := new(big.Int).SetString(tempMessageX, 16)
+	messageY, _ := new(big.Int).SetString(tempMessageY, 16)
+	calldata := bls.verificationCalldata(signature, keyPair.PubKey, bls.NewG1([2]*big.Int{messageX, messageY}))
+	if !bytes.Equal(calldata, expected) {
+		t.Fatalf("verification calldata does not match fixture:\n got: %x", calldata)
+	}
+	if !pairingPrecompile(t, calldata) {
+		t.Fatal("pairing product of valid signature is not one")
+	}
+
+	calldata[31] ^= 1
+	calldata[63] ^= 1
+	if pairingPrecompile(t, calldata) {
+		t.Fatal("pairing product of tampered input is one")
+	}
+}
+
+func TestVerifyFromCalldata(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+	calldata, err := bls.VerificationCalldata(signature, keyPair.PubKey, tempMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := bls.VerifyFromCalldata(calldata)
+	if err != nil || !ok {
+		t.Fatalf("expected calldata to verify, ok: %v, err: %v", ok, err)
+	}
+
+	wrongGenerator := append([]byte{}, calldata...)
+	copy(wrongGenerator[SignatureSize:pairingSize], bls.PubKeyToBytes(bls.bn128.G2.G))
+	if _, err := bls.VerifyFromCalldata(wrongGenerator); err == nil {
+		t.Fatal("expected error for calldata without negated generator")
+	}
+	if _, err := bls.VerifyFromCalldata(calldata[:pairingSize]); err == nil {
+		t.Fatal("expected error for truncated calldata")
+	}
+}
+
+func TestOnChainPairingCount(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	messages := tempMessages
+	signatures := [][3]*big.Int{}
+	for i, message := range messages {
+		signature, _ := bls.SignBytes(keyPairs[i], message)
+		signatures = append(signatures, signature)
+	}
+	aggSig, _ := bls.AggregateSignatures(signatures)
+	fastAggSig, _ := bls.AggregateSignatures(signAll(t, keyPairs, tempMessage))
+
+	single, err := bls.VerificationCalldata(signatures[0], pubKeys[0], messages[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	fast, err := bls.FastAggregateVerificationCalldata(fastAggSig, pubKeys, tempMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggregate, err := bls.AggregateVerificationCalldata(aggSig, pubKeys[:len(messages)], messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, c := range map[string]struct {
+		calldata []byte
+		pairs    int
+	}{
+		"single":    {single, 2},
+		"fast":      {fast, 2},
+		"aggregate": {aggregate, len(messages) + 1},
+	} {
+		pairs, err := OnChainPairingCount(c.calldata)
+		if err != nil || pairs != c.pairs {
+			t.Fatalf("%s: expected %d pairs, got %d, err: %v", name, c.pairs, pairs, err)
+		}
+	}
+
+	// Aggregate Calldata Must Satisfy Precompile Relation.
+	g1Points := [][3]*big.Int{}
+	g2Points := [][3][2]*big.Int{}
+	for offset := 0; offset < len(aggregate); offset += pairingSize {
+		g1, _ := bls.SignatureFromBytes(aggregate[offset : offset+SignatureSize])
+		g2, _ := bls.pubKeyFromBytes(aggregate[offset+SignatureSize : offset+pairingSize])
+		g1Points = append(g1Points, g1)
+		g2Points = append(g2Points, g2)
+	}
+	if !bls.pairingCheck(g1Points, g2Points) {
+		t.Fatal("expected aggregate calldata to pass pairing check")
+	}
+
+	if _, err := OnChainPairingCount(single[:pairingSize+1]); err == nil {
+		t.Fatal("expected error for partial pair")
+	}
+}
+
+func TestVerifyBase64(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+	signatureB64 := base64.StdEncoding.EncodeToString(bls.SignatureToBytes(signature))
+	pubKeyB64 := base64.StdEncoding.EncodeToString(bls.PubKeyToBytes(keyPair.PubKey))
+	messageB64 := base64.StdEncoding.EncodeToString(tempMessage)
+
+	ok, err := bls.VerifyBase64(signatureB64, pubKeyB64, messageB64)
+	if err != nil || !ok {
+		t.Fatalf("expected base64 inputs to verify, ok: %v, err: %v", ok, err)
+	}
+	ok, err = bls.VerifyBase64(signatureB64, pubKeyB64, base64.StdEncoding.EncodeToString([]byte("other message")))
+	if err != nil || ok {
+		t.Fatalf("expected other message to fail, ok: %v, err: %v", ok, err)
+	}
+	if _, err := bls.VerifyBase64("not base64!", pubKeyB64, messageB64); err == nil {
+		t.Fatal("expected error for invalid base64")
+	}
+}
+
+func TestSignatureDigest(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+	if bls.SignatureDigest(signature) != bls.SignatureDigest(rescaleG1(signature, 7)) {
+		t.Fatal("expected digest to be independent of jacobian representation")
+	}
+	other, _ := bls.SignBytes(keyPair, []byte("other message"))
+	if bls.SignatureDigest(signature) == bls.SignatureDigest(other) {
+		t.Fatal("expected different signatures to have different digests")
+	}
+}
+
+func TestVerifyUncompressedPubKey(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+	pubKeyBytes := bls.PubKeyToBytes(keyPair.PubKey)
+
+	pubKey, err := bls.PubKeyFromBytes(pubKeyBytes)
+	if err != nil || !bls.bn128.G2.Equal(pubKey, keyPair.PubKey) {
+		t.Fatalf("expected pubKey to round-trip, err: %v", err)
+	}
+	ok, err := bls.VerifyUncompressedPubKey(signature, pubKeyBytes, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected signature to verify, ok: %v, err: %v", ok, err)
+	}
+
+	if _, err := bls.VerifyUncompressedPubKey(signature, pubKeyBytes[:PubKeySize-1], tempMessage); err == nil {
+		t.Fatal("expected error for short pubKey blob")
+	}
+	offSubgroup := bls.PubKeyToBytes(randomTwistPoint(t))
+	if _, err := bls.VerifyUncompressedPubKey(signature, offSubgroup, tempMessage); !errors.Is(err, ErrNotInSubgroup) {
+		t.Fatalf("expected ErrNotInSubgroup, got: %v", err)
+	}
+}
+
+func TestToFixed32(t *testing.T) {
+	fixed := ToFixed32(big.NewInt(0x0102))
+	if fixed[30] != 0x01 || fixed[31] != 0x02 || !bytes.Equal(fixed[:30], make([]byte, 30)) {
+		t.Fatalf("unexpected encoding %x", fixed)
+	}
+
+	// Search For Signature Whose Affine x Has Leading Zero Byte.
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	for i := 0; ; i++ {
+		signature, _ := bls.SignBytes(keyPair, []byte(fmt.Sprintf("leading zero %d", i)))
+		affine := bls.ParseSignature(signature)
+		if affine[0].BitLen() > 8*(coordinateSize-1) {
+			continue
+		}
+		data := bls.SignatureToBytes(signature)
+		if len(data) != SignatureSize || data[0] != 0 {
+			t.Fatalf("expected fixed-width encoding with leading zero, got %x", data)
+		}
+		decoded, err := bls.SignatureFromBytes(data)
+		if err != nil || !bls.bn128.G1.Equal(decoded, signature) {
+			t.Fatalf("expected signature to round-trip, err: %v", err)
+		}
+		break
+	}
+}
+
+func TestVerifyMixedEndian(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+	sigBytes := bls.SignatureToBytes(signature)
+	littleEndianPubKey, _ := coordinatesToBigEndian(bls.PubKeyToBytes(keyPair.PubKey), binary.LittleEndian)
+
+	ok, err := bls.VerifyMixedEndian(sigBytes, binary.BigEndian, littleEndianPubKey, binary.LittleEndian, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected mixed endian inputs to verify, ok: %v, err: %v", ok, err)
+	}
+	// Decoding Little-Endian PubKey As Big-Endian Gives Unreduced Or Off-Curve Coordinates.
+	if ok, err := bls.VerifyMixedEndian(sigBytes, binary.BigEndian, littleEndianPubKey, binary.BigEndian, tempMessage); err == nil || ok {
+		t.Fatalf("expected error for mismatched pubKey byte order, ok: %v", ok)
+	}
+	if _, err := bls.VerifyMixedEndian(sigBytes[:SignatureSize-1], binary.BigEndian, littleEndianPubKey, binary.LittleEndian, tempMessage); err == nil {
+		t.Fatal("expected error for truncated signature")
+	}
+}
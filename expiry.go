@@ -0,0 +1,82 @@
+package bn128_bls
+
+// Expiring Signatures, Expiry Is Bound Into Signed Message As uint64 Unix Seconds || message, Hashed Under Separate
+// Expiry DST "BN128_BLS_EXPIRY_" || DST, So It Cannot Be Changed Without Invalidating Signature.
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+const expiryDSTPrefix = "BN128_BLS_EXPIRY_"
+
+var ErrSignatureExpired = errors.New("signature has expired")
+
+// Sets How Far Past Expiry Signatures Are Still Accepted By VerifyWithExpiry, To Tolerate Clock Differences Between Machines.
+// Larger Skew Extends Lifetime Of Every Signature, Including Leaked Or Revoked Ones, By Same Amount.
+// Returns ErrConfigLocked Once Instance Has Been Used To Sign Or Verify.
+func (bls *BLS) SetClockSkew(skew time.Duration) error {
+	if bls.used.Load() {
+		return ErrConfigLocked
+	}
+	if skew < 0 {
+		return fmt.Errorf("invalid clock skew %v, it must not be negative", skew)
+	}
+	bls.clockSkew = skew
+	return nil
+}
+
+// Signs `message` Together With `expiry` (Second Precision), `expiry` Must Not Be Before Unix Epoch.
+func (bls *BLS) SignWithExpiry(keyPair *KeyPair, message []byte, expiry time.Time) ([3]*big.Int, error) {
+	if err := checkExpiry(expiry); err != nil {
+		return [3]*big.Int{}, err
+	}
+	return bls.signForPurpose(keyPair, expiryDSTPrefix, expiryMessage(message, expiry))
+}
+
+// Verifies Signature Produced By SignWithExpiry, Returning ErrSignatureExpired If Current Time Is Past `expiry` Plus Clock Skew.
+// Signature Is Still Valid At Exactly `expiry`.
+func (bls *BLS) VerifyWithExpiry(signature [3]*big.Int, signerPubKey [3][2]*big.Int, message []byte, expiry time.Time) (bool, error) {
+	ok, _, err := bls.VerifyWithExpiryInfo(signature, signerPubKey, message, expiry)
+	return ok, err
+}
+
+// Same As VerifyWithExpiry But Also Returns Time Remaining Until `expiry`, Useful As Cache TTL.
+// Remaining Excludes Clock Skew, So It Is Negative For Signatures Accepted Only Thanks To Skew And For Expired Ones.
+func (bls *BLS) VerifyWithExpiryInfo(signature [3]*big.Int, signerPubKey [3][2]*big.Int, message []byte, expiry time.Time) (bool, time.Duration, error) {
+	if err := checkExpiry(expiry); err != nil {
+		return false, 0, err
+	}
+	expiry = time.Unix(expiry.Unix(), 0)
+	now := bls.now()
+	remaining := expiry.Sub(now)
+	if now.After(expiry.Add(bls.clockSkew)) {
+		return false, remaining, fmt.Errorf("%w: expired at %v, now is %v, clock skew is %v", ErrSignatureExpired, expiry.UTC(), now.UTC(), bls.clockSkew)
+	}
+	ok, err := bls.verifyForPurpose(signature, signerPubKey, expiryDSTPrefix, expiryMessage(message, expiry))
+	return ok, remaining, err
+}
+
+func (bls *BLS) now() time.Time {
+	if bls.clock != nil {
+		return bls.clock()
+	}
+	return time.Now()
+}
+
+// Expiry Is Encoded As uint64 Unix Seconds, Negative Value Would Wrap Around To Far Future.
+func checkExpiry(expiry time.Time) error {
+	if expiry.Unix() < 0 {
+		return fmt.Errorf("invalid expiry %v, it must not be before unix epoch", expiry.UTC())
+	}
+	return nil
+}
+
+func expiryMessage(message []byte, expiry time.Time) []byte {
+	data := make([]byte, 0, 8+len(message))
+	data = binary.BigEndian.AppendUint64(data, uint64(expiry.Unix()))
+	return append(data, message...)
+}
@@ -0,0 +1,80 @@
+package bn128_bls
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestVerifyWithExpiry(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	expiry := time.Unix(1700000000, 0)
+	signature, err := bls.SignWithExpiry(keyPair, tempMessage, expiry)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	skewedBls := NewBls()
+	if err := skewedBls.SetClockSkew(-time.Second); err == nil || errors.Is(err, ErrConfigLocked) {
+		t.Fatalf("expected error for negative skew, got: %v", err)
+	}
+	if err := skewedBls.SetClockSkew(30 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	now := expiry
+	skewedBls.clock = func() time.Time { return now }
+
+	ok, err := skewedBls.VerifyWithExpiry(signature, keyPair.PubKey, tempMessage, expiry)
+	if err != nil || !ok {
+		t.Fatalf("expected signature to verify at expiry, ok: %v, err: %v", ok, err)
+	}
+	plain, _ := bls.SignBytes(keyPair, append([]byte("BN128_BLS_EXPIRY_"), expiryMessage(tempMessage, expiry)...))
+	if ok, _ := skewedBls.VerifyWithExpiry(plain, keyPair.PubKey, tempMessage, expiry); ok {
+		t.Fatal("expected plain signature over prefixed message not to verify as expiring signature")
+	}
+
+	now = expiry.Add(30 * time.Second)
+	ok, err = skewedBls.VerifyWithExpiry(signature, keyPair.PubKey, tempMessage, expiry)
+	if err != nil || !ok {
+		t.Fatalf("expected signature to verify within skew, ok: %v, err: %v", ok, err)
+	}
+
+	now = expiry.Add(31 * time.Second)
+	if _, err := skewedBls.VerifyWithExpiry(signature, keyPair.PubKey, tempMessage, expiry); !errors.Is(err, ErrSignatureExpired) {
+		t.Fatalf("expected ErrSignatureExpired beyond skew, got: %v", err)
+	}
+
+	beforeEpoch := time.Unix(-1, 0)
+	if _, err := bls.SignWithExpiry(keyPair, tempMessage, beforeEpoch); err == nil {
+		t.Fatal("expected error for expiry before unix epoch")
+	}
+	if _, err := skewedBls.VerifyWithExpiry(signature, keyPair.PubKey, tempMessage, beforeEpoch); err == nil || errors.Is(err, ErrSignatureExpired) {
+		t.Fatalf("expected invalid expiry error, got: %v", err)
+	}
+}
+
+func TestVerifyWithExpiryInfo(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	expiry := time.Unix(1700000000, 0)
+	signature, _ := bls.SignWithExpiry(keyPair, tempMessage, expiry)
+
+	clockBls := NewBls()
+	now := expiry.Add(-time.Hour)
+	clockBls.clock = func() time.Time { return now }
+	ok, remaining, err := clockBls.VerifyWithExpiryInfo(signature, keyPair.PubKey, tempMessage, expiry)
+	if err != nil || !ok || remaining != time.Hour {
+		t.Fatalf("expected fresh signature with 1h remaining, ok: %v, remaining: %v, err: %v", ok, remaining, err)
+	}
+
+	now = expiry.Add(-time.Second)
+	ok, remaining, err = clockBls.VerifyWithExpiryInfo(signature, keyPair.PubKey, tempMessage, expiry)
+	if err != nil || !ok || remaining != time.Second {
+		t.Fatalf("expected near-expiry signature with 1s remaining, ok: %v, remaining: %v, err: %v", ok, remaining, err)
+	}
+
+	now = expiry.Add(time.Minute)
+	ok, remaining, err = clockBls.VerifyWithExpiryInfo(signature, keyPair.PubKey, tempMessage, expiry)
+	if !errors.Is(err, ErrSignatureExpired) || ok || remaining != -time.Minute {
+		t.Fatalf("expected expired signature with -1m remaining, ok: %v, remaining: %v, err: %v", ok, remaining, err)
+	}
+}
@@ -2,4 +2,9 @@ module bn128_bls
 
 go 1.20
 
-require github.com/arnaucube/go-snark v0.0.4
+require (
+	github.com/arnaucube/go-snark v0.0.4
+	golang.org/x/crypto v0.25.0
+)
+
+require golang.org/x/sys v0.22.0 // indirect
@@ -0,0 +1,291 @@
+package bn128_bls
+
+// Hashing Of Arbitrary Byte Messages To G1 Points, So Callers Do Not Need To Perform HashToPoint Themselves.
+// Digest Is Keccak256(message || DST || len(DST)), Which Is Then Mapped To Curve Using Try-And-Increment:
+// x Starts At Digest Reduced Into Field, y Is Computed As (x^3 + 3)^((Q+1)/4) And x Is Incremented Until Point Is On Curve.
+// Same Steps Can Be Replicated In Solidity Using `keccak256` And `modexp` Precompile.
+//
+// Field Framing: len(DST) Is Single Byte (SetDST Limits DST To 255 Bytes), Reading It From End Of Input Locates DST,
+// So Distinct (message, DST) Pairs Never Produce Same Hash Input Even When Their Plain Concatenations Are Equal,
+// Same Construction As DST_prime Of RFC 9380.
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Domain Separation Tag Used By NewBls, Can Be Changed Using SetDST.
+const DefaultDST = "BN128_BLS_SIG_KECCAK256_TAI_"
+
+// Returned By Hashing Setters After First Sign Or Verify, Changing Them Mid-Lifetime Would Silently Split Signatures
+// Into Incompatible Groups, Create New Instance Instead.
+var ErrConfigLocked = errors.New("configuration is locked after first use")
+
+// Sets Domain Separation Tag Appended To Every Message Before Hashing, It Must Be 1 To 255 Bytes Long.
+// Returns ErrConfigLocked Once Instance Has Been Used To Sign Or Verify.
+func (bls *BLS) SetDST(dst []byte) error {
+	if bls.used.Load() {
+		return ErrConfigLocked
+	}
+	if len(dst) < 1 || len(dst) > 255 {
+		return fmt.Errorf("invalid dst length %d, it must be between 1 and 255", len(dst))
+	}
+	bls.dst = append([]byte{}, dst...)
+	return nil
+}
+
+// When Enabled Message Digest Is Reduced Modulo R (Scalar Field) Instead Of Q (Base Field) And Reduced Value u
+// Is Mapped Using SVDW map_to_curve (See svdw.go), For Protocols Which Have Standardized On Scalar-Field Hashing.
+// Single Map Of One Field Element Is Not Uniform Over G1, Like encode_to_curve Of RFC 9380.
+// u Is Never Used As Scalar Multiplier Of Generator (k·G Offset Map), Because Message Point With Known Discrete Log
+// Would Let Anyone Forge Signatures Using `PubKeyG1`. Ignored Unless HashToCurveTryAndIncrement Is Selected By SetHashToCurve.
+// Returns ErrConfigLocked Once Instance Has Been Used To Sign Or Verify.
+func (bls *BLS) MapViaScalarField(enabled bool) error {
+	if bls.used.Load() {
+		return ErrConfigLocked
+	}
+	bls.mapViaScalarField = enabled
+	return nil
+}
+
+// Hashes `message` With Configured DST And Maps Resulting Digest To G1 Point,
+// Using HashToG1SVDW Instead When Selected By SetHashToCurve.
+func (bls *BLS) HashToG1(message []byte) [3]*big.Int {
+	return bls.hashToG1WithDST(message, bls.dst)
+}
+
+// Hashes `message` Under Per-Purpose DST `prefix` || DST, So Signature Made For One Purpose Is Never Valid For Another
+// One Or As Plain SignBytes Signature. DST Longer Than 255 Bytes Is Replaced By Keccak256("H2C-OVERSIZE-DST-" || DST),
+// As In RFC 9380 Section 5.3.3.
+func (bls *BLS) hashToG1ForPurpose(prefix string, message []byte) [3]*big.Int {
+	dst := append([]byte(prefix), bls.dst...)
+	if len(dst) > 255 {
+		digest := keccak256([]byte("H2C-OVERSIZE-DST-"), dst)
+		dst = digest[:]
+	}
+	return bls.hashToG1WithDST(message, dst)
+}
+
+// Signs `message` Hashed Under Per-Purpose DST `prefix` || DST, See hashToG1ForPurpose.
+func (bls *BLS) signForPurpose(keyPair *KeyPair, prefix string, message []byte) ([3]*big.Int, error) {
+	return bls.signPoint(keyPair, bls.hashToG1ForPurpose(prefix, message))
+}
+
+// Same As VerifyBytes With `message` Hashed Under Per-Purpose DST `prefix` || DST.
+func (bls *BLS) verifyForPurpose(signature [3]*big.Int, signerPubKey [3][2]*big.Int, prefix string, message []byte) (bool, error) {
+	if err := bls.ValidateSignature(signature); err != nil {
+		return false, fmt.Errorf("invalid signature: %w", err)
+	}
+	if err := bls.ValidatePubKey(signerPubKey); err != nil {
+		return false, fmt.Errorf("invalid signerPubKey: %w", err)
+	}
+	return bls.verifyPoint(signature, signerPubKey, bls.hashToG1ForPurpose(prefix, message)), nil
+}
+
+// Same As HashToG1 Under `dst` Instead Of Configured DST, `dst` Must Be 1 To 255 Bytes Long.
+func (bls *BLS) hashToG1WithDST(message []byte, dst []byte) [3]*big.Int {
+	if bls.hashToCurve == HashToCurveSVDW {
+		return bls.hashToG1XMD(message, dst)
+	}
+	return bls.digestToG1(messageDigest(message, dst))
+}
+
+// Maps Digest Produced By MessageDigest To G1 Point, Second Half Of HashToG1.
+func (bls *BLS) digestToG1(digest [32]byte) [3]*big.Int {
+	bls.used.Store(true)
+	if bls.mapViaScalarField {
+		return bls.mapToG1SVDW(new(big.Int).Mod(new(big.Int).SetBytes(digest[:]), bls.bn128.R))
+	}
+	return bls.mapToG1(new(big.Int).Mod(new(big.Int).SetBytes(digest[:]), bls.bn128.Q))
+}
+
+// Maps Every Message To G1 Using Pool Of `workers` Goroutines, Output Order Matches Input Order.
+func (bls *BLS) HashToG1Batch(messages [][]byte, workers int) ([][3]*big.Int, error) {
+	if workers < 1 {
+		return nil, fmt.Errorf("invalid workers count %d", workers)
+	}
+	points := make([][3]*big.Int, len(messages))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(messages); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				points[i] = bls.HashToG1(messages[i])
+			}
+		}()
+	}
+	for i := range messages {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return points, nil
+}
+
+// Hashes `message` To G1 Point And Signs It.
+func (bls *BLS) SignBytes(keyPair *KeyPair, message []byte) ([3]*big.Int, error) {
+	return bls.signPoint(keyPair, bls.HashToG1(message))
+}
+
+// Signs Every Message With `newKeyPair`, Used To Re-Sign Pending Messages After Key Rotation.
+// Either All Signatures Are Returned Or None.
+func (bls *BLS) ResignAll(newKeyPair *KeyPair, messages [][]byte) ([][3]*big.Int, error) {
+	signatures := make([][3]*big.Int, len(messages))
+	for i, message := range messages {
+		signature, err := bls.SignBytes(newKeyPair, message)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign message at index %d: %w", i, err)
+		}
+		signatures[i] = signature
+	}
+	return signatures, nil
+}
+
+// Same As SignBytes But Also Returns Hashed Message Point, So It Can Be Cached And Passed To VerifyWithPoint.
+func (bls *BLS) SignBytesWithPoint(keyPair *KeyPair, message []byte) ([3]*big.Int, [3]*big.Int, error) {
+	messagePoint := bls.HashToG1(message)
+	signature, err := bls.signPoint(keyPair, messagePoint)
+	if err != nil {
+		return [3]*big.Int{}, [3]*big.Int{}, err
+	}
+	return signature, messagePoint, nil
+}
+
+// Verifies `signature` Against Already Hashed `messagePoint`, Skipping HashToG1.
+// `messagePoint` Is Validated Same Way As Signature, Caller Is Still Responsible For It Being Hash Of Intended Message.
+func (bls *BLS) VerifyWithPoint(signature [3]*big.Int, signerPubKey [3][2]*big.Int, messagePoint [3]*big.Int) (bool, error) {
+	if err := bls.ValidateSignature(messagePoint); err != nil {
+		return false, fmt.Errorf("invalid messagePoint: %w", err)
+	}
+	if err := bls.ValidateSignature(signature); err != nil {
+		return false, fmt.Errorf("invalid signature: %w", err)
+	}
+	if err := bls.ValidatePubKey(signerPubKey); err != nil {
+		return false, fmt.Errorf("invalid signerPubKey: %w", err)
+	}
+	return bls.verifyPoint(signature, signerPubKey, messagePoint), nil
+}
+
+// Verifies Signature Produced By SignBytes, Signature And PubKey Are Always Validated (On Curve, Subgroup, Not Infinity) Before Pairing.
+// Prefer This Over VerifySignature For Inputs Coming From Outside.
+func (bls *BLS) VerifyBytes(signature [3]*big.Int, signerPubKey [3][2]*big.Int, message []byte) (bool, error) {
+	if err := bls.ValidateSignature(signature); err != nil {
+		return false, fmt.Errorf("invalid signature: %w", err)
+	}
+	if err := bls.ValidatePubKey(signerPubKey); err != nil {
+		return false, fmt.Errorf("invalid signerPubKey: %w", err)
+	}
+	return bls.verifyPoint(signature, signerPubKey, bls.HashToG1(message)), nil
+}
+
+// Signs 32 Byte `prehash`, Counterpart Of VerifyPrehashed With Same `alreadyDomainSeparated` Meaning.
+func (bls *BLS) SignPrehashed(keyPair *KeyPair, prehash [32]byte, alreadyDomainSeparated bool) ([3]*big.Int, error) {
+	messageG1, err := bls.prehashToG1(prehash, alreadyDomainSeparated)
+	if err != nil {
+		return [3]*big.Int{}, err
+	}
+	return bls.signPoint(keyPair, messageG1)
+}
+
+// Verifies Signature Over 32 Byte `prehash`. When `alreadyDomainSeparated` Is True `prehash` Is Taken As MessageDigest Output
+// And Mapped To Curve Directly, So SignBytes(m) Verifies With MessageDigest(m). Otherwise `prehash` Is Hashed Again
+// With DST Like Any Other Message, Matching Signers Which Pass Their SHA-256 Or Similar Digest To SignBytes.
+func (bls *BLS) VerifyPrehashed(signature [3]*big.Int, signerPubKey [3][2]*big.Int, prehash [32]byte, alreadyDomainSeparated bool) (bool, error) {
+	if err := bls.ValidateSignature(signature); err != nil {
+		return false, fmt.Errorf("invalid signature: %w", err)
+	}
+	if err := bls.ValidatePubKey(signerPubKey); err != nil {
+		return false, fmt.Errorf("invalid signerPubKey: %w", err)
+	}
+	messageG1, err := bls.prehashToG1(prehash, alreadyDomainSeparated)
+	if err != nil {
+		return false, err
+	}
+	return bls.verifyPoint(signature, signerPubKey, messageG1), nil
+}
+
+// Digest Can Be Mapped Directly Only With Try-And-Increment, SVDW Derives Field Elements From Message Itself.
+func (bls *BLS) prehashToG1(prehash [32]byte, alreadyDomainSeparated bool) ([3]*big.Int, error) {
+	if !alreadyDomainSeparated {
+		return bls.HashToG1(prehash[:]), nil
+	}
+	if bls.hashToCurve != HashToCurveTryAndIncrement {
+		return [3]*big.Int{}, fmt.Errorf("domain separated prehash requires %v hash to curve, got %v", HashToCurveTryAndIncrement, bls.hashToCurve)
+	}
+	return bls.digestToG1(prehash), nil
+}
+
+// Returns Index Of First Candidate Message `signature` Verifies For Under `signerPubKey`, Or -1 If None Does.
+// Debugging Aid For Integrations Where Signer And Verifier Disagree On Message Encoding.
+func (bls *BLS) WhichMessage(signature [3]*big.Int, signerPubKey [3][2]*big.Int, candidates [][]byte) (int, error) {
+	if err := bls.ValidateSignature(signature); err != nil {
+		return -1, fmt.Errorf("invalid signature: %w", err)
+	}
+	if err := bls.ValidatePubKey(signerPubKey); err != nil {
+		return -1, fmt.Errorf("invalid signerPubKey: %w", err)
+	}
+	for i, candidate := range candidates {
+		if bls.verifyPoint(signature, signerPubKey, bls.HashToG1(candidate)) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// Same As VerifyBytes But Checks e(H(m), pubKey) == e(signature, g2Generator), For Systems Whose PubKeys Are sk·g2Generator.
+// Experimental: `g2Generator` Is Validated Like A PubKey, Nothing Else Is Assumed About It.
+func (bls *BLS) VerifySignatureWithGenerator(signature [3]*big.Int, signerPubKey [3][2]*big.Int, message []byte, g2Generator [3][2]*big.Int) (bool, error) {
+	if err := bls.ValidateSignature(signature); err != nil {
+		return false, fmt.Errorf("invalid signature: %w", err)
+	}
+	if err := bls.ValidatePubKey(signerPubKey); err != nil {
+		return false, fmt.Errorf("invalid signerPubKey: %w", err)
+	}
+	if err := bls.ValidatePubKey(g2Generator); err != nil {
+		return false, fmt.Errorf("invalid g2Generator: %w", err)
+	}
+	return bls.verifyPointWithGenerator(signature, signerPubKey, bls.HashToG1(message), g2Generator), nil
+}
+
+// Returns Keccak256 Of Configured DST, Lets Two Parties Cheaply Compare Their Domain Separation.
+func (bls *BLS) DSTFingerprint() [32]byte {
+	return keccak256(bls.dst)
+}
+
+// Returns Keccak256(message || DST || len(DST)), Digest Which HashToG1 Maps To Curve Under Try-And-Increment, So Signer And Verifier Can Record Exactly What Was Hashed.
+func (bls *BLS) MessageDigest(message []byte) [32]byte {
+	return messageDigest(message, bls.dst)
+}
+
+func messageDigest(message []byte, dst []byte) [32]byte {
+	return keccak256(message, dst, []byte{byte(len(dst))})
+}
+
+func keccak256(data ...[]byte) [32]byte {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		hasher.Write(d)
+	}
+	var digest [32]byte
+	hasher.Sum(digest[:0])
+	return digest
+}
+
+func (bls *BLS) mapToG1(x *big.Int) [3]*big.Int {
+	fq := bls.bn128.Fq1
+	x = fq.Affine(x)
+	for {
+		y2 := fq.Add(fq.Mul(fq.Square(x), x), bls.bn128.CoefB)
+		y := new(big.Int).ModSqrt(y2, bls.bn128.Q)
+		if y != nil {
+			return [3]*big.Int{x, y, fq.One()}
+		}
+		x = fq.Add(x, fq.One())
+	}
+}
@@ -0,0 +1,288 @@
+package bn128_bls
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/sha3"
+)
+
+var tempMessage = []byte("bn128_bls test message")
+
+func isOnCurveAffineG1(point [2]*big.Int) bool {
+	fq := bls.bn128.Fq1
+	lhs := fq.Square(point[1])
+	rhs := fq.Add(fq.Mul(fq.Square(point[0]), point[0]), bls.bn128.CoefB)
+	return fq.Equal(lhs, rhs)
+}
+
+func TestHashToG1(t *testing.T) {
+	point := bls.HashToG1(tempMessage)
+	if !isOnCurveAffineG1(bls.ParseSignature(point)) {
+		t.Fatal("hashed point is not on curve")
+	}
+	if !bls.bn128.G1.Equal(point, bls.HashToG1(tempMessage)) {
+		t.Fatal("hashing same message twice gave different points")
+	}
+
+	otherBls := NewBls()
+	if err := otherBls.SetDST([]byte("OTHER_DST")); err != nil {
+		t.Fatal(err)
+	}
+	if bls.bn128.G1.Equal(point, otherBls.HashToG1(tempMessage)) {
+		t.Fatal("different dst gave same point")
+	}
+	if err := otherBls.SetDST(nil); err == nil {
+		t.Fatal("expected error for empty dst")
+	}
+}
+
+func TestSignBytes(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, err := bls.SignBytes(keyPair, tempMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := bls.VerifyBytes(signature, keyPair.PubKey, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected signature to verify, ok: %v, err: %v", ok, err)
+	}
+}
+
+func TestMapViaScalarField(t *testing.T) {
+	scalarBls := NewBls()
+	scalarBls.MapViaScalarField(true)
+
+	point := scalarBls.HashToG1(tempMessage)
+	if !isOnCurveAffineG1(scalarBls.ParseSignature(point)) {
+		t.Fatal("hashed point is not on curve")
+	}
+	if scalarBls.bn128.G1.Equal(point, bls.HashToG1(tempMessage)) {
+		t.Fatal("scalar field reduction gave same point as base field reduction")
+	}
+	digest := scalarBls.MessageDigest(tempMessage)
+	u := new(big.Int).Mod(new(big.Int).SetBytes(digest[:]), scalarBls.bn128.R)
+	if !scalarBls.bn128.G1.Equal(point, scalarBls.mapToG1SVDW(u)) {
+		t.Fatal("expected scalar field mapping to be svdw map of digest mod r")
+	}
+
+	keyPair, _ := scalarBls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := scalarBls.SignBytes(keyPair, tempMessage)
+	ok, err := scalarBls.VerifyBytes(signature, keyPair.PubKey, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected signature to verify under scalar field mapping, ok: %v, err: %v", ok, err)
+	}
+}
+
+func TestSignBytesWithPoint(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, messagePoint, err := bls.SignBytesWithPoint(keyPair, tempMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bls.bn128.G1.Equal(messagePoint, bls.HashToG1(tempMessage)) {
+		t.Fatal("returned message point does not match HashToG1")
+	}
+	ok, err := bls.VerifyWithPoint(signature, keyPair.PubKey, messagePoint)
+	if err != nil || !ok {
+		t.Fatalf("expected signature to verify with returned point, ok: %v, err: %v", ok, err)
+	}
+
+	offCurvePoint := [3]*big.Int{messagePoint[0], big.NewInt(1), messagePoint[2]}
+	if _, err := bls.VerifyWithPoint(signature, keyPair.PubKey, offCurvePoint); err == nil {
+		t.Fatal("expected error for off curve message point")
+	}
+}
+
+func TestVerifySignatureWithGenerator(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	g2Generator := bls.bn128.G2.MulScalar(bls.bn128.G2.G, big.NewInt(7))
+	rotatedPubKey := bls.bn128.G2.MulScalar(g2Generator, keyPair.PrivateKey)
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+
+	ok, err := bls.VerifySignatureWithGenerator(signature, rotatedPubKey, tempMessage, g2Generator)
+	if err != nil || !ok {
+		t.Fatalf("expected signature to verify under rotated generator, ok: %v, err: %v", ok, err)
+	}
+	ok, err = bls.VerifyBytes(signature, rotatedPubKey, tempMessage)
+	if err != nil || ok {
+		t.Fatalf("expected rotated pubKey to fail under standard generator, ok: %v, err: %v", ok, err)
+	}
+
+	if _, err := bls.VerifySignatureWithGenerator(signature, rotatedPubKey, tempMessage, bls.bn128.G2.Zero()); !errors.Is(err, ErrPointAtInfinity) {
+		t.Fatalf("expected ErrPointAtInfinity for identity generator, got: %v", err)
+	}
+}
+
+func TestWhichMessage(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+	candidates := [][]byte{[]byte("bn128_bls test message\n"), []byte("BN128_BLS TEST MESSAGE"), tempMessage}
+
+	index, err := bls.WhichMessage(signature, keyPair.PubKey, candidates)
+	if err != nil || index != 2 {
+		t.Fatalf("expected index 2, got: %d, err: %v", index, err)
+	}
+	index, err = bls.WhichMessage(signature, keyPair.PubKey, candidates[:1])
+	if err != nil || index != -1 {
+		t.Fatalf("expected index -1, got: %d, err: %v", index, err)
+	}
+}
+
+func TestMessageDigest(t *testing.T) {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(tempMessage)
+	hasher.Write([]byte(DefaultDST))
+	hasher.Write([]byte{byte(len(DefaultDST))})
+	digest := bls.MessageDigest(tempMessage)
+	if !bytes.Equal(digest[:], hasher.Sum(nil)) {
+		t.Fatal("expected digest to equal keccak256(message || DST || len(DST))")
+	}
+}
+
+// ("..._BN128", "_X") And ("...", "_BN128_X") Have Same message || DST, Length Suffix Must Separate Them.
+func TestMessageDigestFraming(t *testing.T) {
+	shortDST, longDST := NewBls(), NewBls()
+	if err := shortDST.SetDST([]byte("_X")); err != nil {
+		t.Fatal(err)
+	}
+	if err := longDST.SetDST([]byte("_BN128_X")); err != nil {
+		t.Fatal(err)
+	}
+	message := []byte("framing")
+	shiftedMessage := append(append([]byte{}, message...), "_BN128"...)
+
+	if !bytes.Equal(append(shiftedMessage, "_X"...), append(message, "_BN128_X"...)) {
+		t.Fatal("test inputs do not concatenate to same bytes")
+	}
+	if shortDST.MessageDigest(shiftedMessage) == longDST.MessageDigest(message) {
+		t.Fatal("expected different digests for different (message, DST) pairs")
+	}
+	if shortDST.bn128.G1.Equal(shortDST.HashToG1(shiftedMessage), longDST.HashToG1(message)) {
+		t.Fatal("expected different points for different (message, DST) pairs")
+	}
+}
+
+// Run With -race: Workers Share Same *BLS And Output Slice.
+func TestHashToG1Batch(t *testing.T) {
+	messages := [][]byte{}
+	for i := 0; i < 32; i++ {
+		messages = append(messages, []byte(fmt.Sprintf("bn128_bls batch hash message %d", i)))
+	}
+	points, err := bls.HashToG1Batch(messages, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(points) != len(messages) {
+		t.Fatalf("expected %d points, got %d", len(messages), len(points))
+	}
+	for i, message := range messages {
+		if !bls.bn128.G1.Equal(points[i], bls.HashToG1(message)) {
+			t.Fatalf("point %d does not match serial HashToG1", i)
+		}
+	}
+
+	if points, err := bls.HashToG1Batch(nil, 4); err != nil || len(points) != 0 {
+		t.Fatalf("expected empty result, got %d points, err: %v", len(points), err)
+	}
+	if _, err := bls.HashToG1Batch(messages, 0); err == nil {
+		t.Fatal("expected error for zero workers")
+	}
+}
+
+func TestResignAll(t *testing.T) {
+	newKeyPair, _ := bls.NewKeyPair("2b6b4c1d3a5e7f9011223344556677889900aabbccddeeff0011223344556677")
+	messages := [][]byte{tempMessage, []byte("pending message")}
+	signatures, err := bls.ResignAll(newKeyPair, messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, message := range messages {
+		ok, err := bls.VerifyBytes(signatures[i], newKeyPair.PubKey, message)
+		if err != nil || !ok {
+			t.Fatalf("expected signature %d to verify under new key, ok: %v, err: %v", i, ok, err)
+		}
+	}
+
+	newKeyPair.Destroy()
+	if _, err := bls.ResignAll(newKeyPair, messages); !errors.Is(err, ErrKeyDestroyed) {
+		t.Fatalf("expected ErrKeyDestroyed, got: %v", err)
+	}
+}
+
+func TestConfigLockedAfterUse(t *testing.T) {
+	lockedBls := NewBls()
+	if err := lockedBls.SetDST([]byte("BEFORE_USE_")); err != nil {
+		t.Fatal(err)
+	}
+	if err := lockedBls.MapViaScalarField(true); err != nil {
+		t.Fatal(err)
+	}
+	keyPair, _ := lockedBls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	if err := lockedBls.SetDST([]byte("STILL_UNUSED_")); err != nil {
+		t.Fatalf("expected key generation not to lock config, got: %v", err)
+	}
+	lockedBls.SignBytes(keyPair, tempMessage)
+
+	if err := lockedBls.SetDST([]byte("AFTER_USE_")); !errors.Is(err, ErrConfigLocked) {
+		t.Fatalf("expected ErrConfigLocked, got: %v", err)
+	}
+	if err := lockedBls.MapViaScalarField(false); !errors.Is(err, ErrConfigLocked) {
+		t.Fatalf("expected ErrConfigLocked, got: %v", err)
+	}
+	if string(lockedBls.dst) != "STILL_UNUSED_" || !lockedBls.mapViaScalarField {
+		t.Fatal("expected locked config to stay unchanged")
+	}
+	if err := lockedBls.SetAllowEmptyAggregate(true); !errors.Is(err, ErrConfigLocked) {
+		t.Fatalf("expected ErrConfigLocked, got: %v", err)
+	}
+	if err := lockedBls.SetDeterministicBatchCoefficients(true); !errors.Is(err, ErrConfigLocked) {
+		t.Fatalf("expected ErrConfigLocked, got: %v", err)
+	}
+	if err := lockedBls.SetLegacyG1CofactorClearing(true); !errors.Is(err, ErrConfigLocked) {
+		t.Fatalf("expected ErrConfigLocked, got: %v", err)
+	}
+	if err := lockedBls.SetClockSkew(time.Second); !errors.Is(err, ErrConfigLocked) {
+		t.Fatalf("expected ErrConfigLocked, got: %v", err)
+	}
+	if err := lockedBls.SetHashToCurve(HashToCurveSVDW); !errors.Is(err, ErrConfigLocked) {
+		t.Fatalf("expected ErrConfigLocked, got: %v", err)
+	}
+
+	fingerprint, _ := lockedBls.ConfigFingerprint()
+	lockedBls.SetPrivateKeySize(128)
+	if resized, _ := lockedBls.ConfigFingerprint(); resized != fingerprint {
+		t.Fatal("expected private key size not to change config fingerprint")
+	}
+}
+
+func TestVerifyPrehashed(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	prehash := sha256.Sum256(tempMessage)
+
+	// Signer Feeding SHA-256 Digest Through Regular Hash-To-Point.
+	signature, _ := bls.SignBytes(keyPair, prehash[:])
+	ok, err := bls.VerifyPrehashed(signature, keyPair.PubKey, prehash, false)
+	if err != nil || !ok {
+		t.Fatalf("expected double hashed signature to verify, ok: %v, err: %v", ok, err)
+	}
+	if ok, _ := bls.VerifyPrehashed(signature, keyPair.PubKey, prehash, true); ok {
+		t.Fatal("expected double hashed signature to fail when mapped directly")
+	}
+
+	// Signer Whose Digest Already Includes DST.
+	signature, _ = bls.SignBytes(keyPair, tempMessage)
+	ok, err = bls.VerifyPrehashed(signature, keyPair.PubKey, bls.MessageDigest(tempMessage), true)
+	if err != nil || !ok {
+		t.Fatalf("expected MessageDigest to verify when mapped directly, ok: %v, err: %v", ok, err)
+	}
+	signature, _ = bls.SignPrehashed(keyPair, prehash, true)
+	if ok, _ := bls.VerifyPrehashed(signature, keyPair.PubKey, prehash, true); !ok {
+		t.Fatal("expected SignPrehashed signature to verify")
+	}
+}
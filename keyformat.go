@@ -0,0 +1,77 @@
+package bn128_bls
+
+// Detection Of Key Encodings By Length And Flag Bits, Mainly To Catch BLS12-381 (ETH2) Keys Passed To This BN254 Package.
+
+import (
+	"errors"
+	"fmt"
+)
+
+type KeyFormat int
+
+const (
+	KeyFormatUnknown KeyFormat = iota
+	// 64 Byte Signature Layout, Also Used For G1 PubKeys.
+	KeyFormatBN254G1
+	// 64 Byte CompressG2 Layout.
+	KeyFormatBN254G2Compressed
+	// 128 Byte PubKeyToBytes Layout.
+	KeyFormatBN254G2
+	KeyFormatBLS12381G1Compressed
+	KeyFormatBLS12381G1
+	KeyFormatBLS12381G2Compressed
+	KeyFormatBLS12381G2
+)
+
+var ErrWrongCurve = errors.New("key is encoded for bls12-381, this package only supports bn254 (alt_bn128)")
+
+func (f KeyFormat) String() string {
+	switch f {
+	case KeyFormatBN254G1:
+		return "bn254-g1"
+	case KeyFormatBN254G2Compressed:
+		return "bn254-g2-compressed"
+	case KeyFormatBN254G2:
+		return "bn254-g2"
+	case KeyFormatBLS12381G1Compressed:
+		return "bls12-381-g1-compressed"
+	case KeyFormatBLS12381G1:
+		return "bls12-381-g1"
+	case KeyFormatBLS12381G2Compressed:
+		return "bls12-381-g2-compressed"
+	case KeyFormatBLS12381G2:
+		return "bls12-381-g2"
+	}
+	return "unknown"
+}
+
+// Reports Which Encoding `data` Looks Like, BLS12-381 Encodings Are Reported Together With ErrWrongCurve.
+// Only Length And Flag Bits Are Inspected, Use Decoding Functions To Actually Validate Point.
+func DetectKeyFormat(data []byte) (KeyFormat, error) {
+	compressed := len(data) > 0 && data[0]&compressedFlag != 0
+	format := KeyFormatUnknown
+	switch {
+	case len(data) == 64 && compressed:
+		format = KeyFormatBN254G2Compressed
+	case len(data) == 64:
+		format = KeyFormatBN254G1
+	case len(data) == 128 && !compressed:
+		format = KeyFormatBN254G2
+	case len(data) == 48 && compressed:
+		format = KeyFormatBLS12381G1Compressed
+	case len(data) == 96 && compressed:
+		format = KeyFormatBLS12381G2Compressed
+	case len(data) == 96:
+		format = KeyFormatBLS12381G1
+	case len(data) == 192 && !compressed:
+		format = KeyFormatBLS12381G2
+	}
+
+	switch format {
+	case KeyFormatUnknown:
+		return format, fmt.Errorf("unrecognized key encoding of %d bytes", len(data))
+	case KeyFormatBLS12381G1Compressed, KeyFormatBLS12381G1, KeyFormatBLS12381G2Compressed, KeyFormatBLS12381G2:
+		return format, fmt.Errorf("%w: got %d byte %s encoding, bn254 keys are 64 (g1 or compressed g2) or 128 (g2) bytes", ErrWrongCurve, len(data), format)
+	}
+	return format, nil
+}
@@ -0,0 +1,44 @@
+package bn128_bls
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDetectKeyFormat(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	compressed, _ := bls.CompressG2(keyPair.PubKey)
+	withFlag := func(size int) []byte {
+		data := make([]byte, size)
+		data[0] = compressedFlag
+		return data
+	}
+
+	cases := []struct {
+		data       []byte
+		format     KeyFormat
+		wrongCurve bool
+	}{
+		{bls.SignatureToBytes(keyPair.PubKeyG1), KeyFormatBN254G1, false},
+		{compressed[:], KeyFormatBN254G2Compressed, false},
+		{bls.PubKeyToBytes(keyPair.PubKey), KeyFormatBN254G2, false},
+		{withFlag(48), KeyFormatBLS12381G1Compressed, true},
+		{make([]byte, 96), KeyFormatBLS12381G1, true},
+		{withFlag(96), KeyFormatBLS12381G2Compressed, true},
+		{make([]byte, 192), KeyFormatBLS12381G2, true},
+		{make([]byte, 48), KeyFormatUnknown, false},
+		{make([]byte, 33), KeyFormatUnknown, false},
+	}
+	for _, c := range cases {
+		format, err := DetectKeyFormat(c.data)
+		if format != c.format {
+			t.Fatalf("%d bytes: expected %s, got %s", len(c.data), c.format, format)
+		}
+		if errors.Is(err, ErrWrongCurve) != c.wrongCurve {
+			t.Fatalf("%d bytes: unexpected error: %v", len(c.data), err)
+		}
+		if c.format == KeyFormatUnknown && err == nil {
+			t.Fatalf("%d bytes: expected error for unknown format", len(c.data))
+		}
+	}
+}
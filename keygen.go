@@ -0,0 +1,45 @@
+package bn128_bls
+
+// Deterministic Key Derivation From Seed Material, Following KeyGen Of draft-irtf-cfrg-bls-signature-05 Section 2.3
+// (HKDF-SHA256 With Salt "BLS-SIG-KEYGEN-SALT-", Re-Hashed Until Non-Zero Scalar Is Produced), Instantiated With BN254 Order R.
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"math/big"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	keyGenSalt = "BLS-SIG-KEYGEN-SALT-"
+	// L = ceil((3 * ceil(log2(R))) / 16).
+	keyGenOKMSize = 48
+	minSeedSize   = 32
+)
+
+// Derives KeyPair From `seed` Which Must Carry At Least 32 Bytes Of Entropy, Same Seed Always Yields Same KeyPair.
+func (bls *BLS) NewKeyPairFromSeed(seed []byte) (*KeyPair, error) {
+	if len(seed) < minSeedSize {
+		return nil, fmt.Errorf("seed is too short, got %d bytes, min is %d", len(seed), minSeedSize)
+	}
+	salt := []byte(keyGenSalt)
+	ikm := append(append([]byte{}, seed...), 0)
+	info := []byte{0, keyGenOKMSize}
+	privateKey := new(big.Int)
+	for privateKey.Sign() == 0 {
+		hashedSalt := sha256.Sum256(salt)
+		salt = hashedSalt[:]
+		okm := make([]byte, keyGenOKMSize)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, salt, info), okm); err != nil {
+			return nil, fmt.Errorf("failed to derive private key: %v", err)
+		}
+		privateKey.Mod(new(big.Int).SetBytes(okm), curveOrder)
+	}
+	return &KeyPair{
+		PrivateKey: privateKey,
+		PubKey:     bls.mulBaseG2(privateKey),
+		PubKeyG1:   bls.mulBaseG1(privateKey),
+	}, nil
+}
@@ -0,0 +1,25 @@
+package bn128_bls
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNewKeyPairFromSeed(t *testing.T) {
+	seed := bytes.Repeat([]byte{0x42}, 32)
+	keyPair, err := bls.NewKeyPairFromSeed(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, _ := bls.NewKeyPairFromSeed(seed)
+	if keyPair.PrivateKey.Cmp(again.PrivateKey) != 0 {
+		t.Fatal("expected seed to produce deterministic private key")
+	}
+	if !bls.bn128.G2.Equal(keyPair.PubKey, bls.bn128.G2.MulScalar(bls.bn128.G2.G, keyPair.PrivateKey)) {
+		t.Fatal("pubKey does not match private key")
+	}
+	other, _ := bls.NewKeyPairFromSeed(append(seed, 0))
+	if keyPair.PrivateKey.Cmp(other.PrivateKey) == 0 {
+		t.Fatal("expected different seeds to produce different keys")
+	}
+}
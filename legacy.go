@@ -0,0 +1,47 @@
+package bn128_bls
+
+// Compatibility With Legacy Signers Which "Cleared Cofactor" Of G1 Message Point By Multiplying It With G2 Cofactor
+// h = 2Q - R (See CofactorG2), Typically After Copying G2 Code Path. G1 Cofactor Is 1, So That Step Is Not Needed,
+// But Resulting Signature sk·[h]H(m) Does Not Verify Against H(m). Because h Is Coprime To R,
+// [h^-1 mod R]sig Recovers Standard Signature sk·H(m), Which Is Then Verified As Usual.
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Inverse Of G2 Cofactor Modulo R.
+var cofactorG2Inverse = new(big.Int).ModInverse(cofactorG2, curveOrder)
+
+// When Enabled, VerifyBytesLegacy Also Accepts Signatures Over [h]H(m) Where h Is G2 Cofactor, Meant Only For Migration Period.
+// Signing Is Never Affected. Returns ErrConfigLocked Once Instance Has Been Used To Sign Or Verify.
+func (bls *BLS) SetLegacyG1CofactorClearing(enabled bool) error {
+	if bls.used.Load() {
+		return ErrConfigLocked
+	}
+	bls.legacyG1CofactorClearing = enabled
+	return nil
+}
+
+// Same As VerifyBytes, But If Signature Fails And SetLegacyG1CofactorClearing Is Enabled,
+// It Is Retried After Undoing Legacy Cofactor Multiplication, Second Result Reports Whether Legacy Path Was Taken.
+func (bls *BLS) VerifyBytesLegacy(signature [3]*big.Int, signerPubKey [3][2]*big.Int, message []byte) (bool, bool, error) {
+	if err := bls.ValidateSignature(signature); err != nil {
+		return false, false, fmt.Errorf("invalid signature: %w", err)
+	}
+	if err := bls.ValidatePubKey(signerPubKey); err != nil {
+		return false, false, fmt.Errorf("invalid signerPubKey: %w", err)
+	}
+	messageG1 := bls.HashToG1(message)
+	if bls.verifyPoint(signature, signerPubKey, messageG1) {
+		return true, false, nil
+	}
+	if !bls.legacyG1CofactorClearing {
+		return false, false, nil
+	}
+	standard := bls.bn128.G1.MulScalar(signature, cofactorG2Inverse)
+	if bls.verifyPoint(standard, signerPubKey, messageG1) {
+		return true, true, nil
+	}
+	return false, false, nil
+}
@@ -0,0 +1,32 @@
+package bn128_bls
+
+import (
+	"testing"
+)
+
+func TestVerifyBytesLegacy(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	messageG1 := bls.HashToG1(tempMessage)
+	legacySignature, _ := bls.signPoint(keyPair, bls.bn128.G1.MulScalar(messageG1, CofactorG2()))
+
+	strict := NewBls()
+	if ok, legacy, err := strict.VerifyBytesLegacy(legacySignature, keyPair.PubKey, tempMessage); err != nil || ok || legacy {
+		t.Fatalf("expected legacy signature to fail without flag, ok: %v, legacy: %v, err: %v", ok, legacy, err)
+	}
+
+	compatible := NewBls()
+	if err := compatible.SetLegacyG1CofactorClearing(true); err != nil {
+		t.Fatal(err)
+	}
+	if ok, legacy, err := compatible.VerifyBytesLegacy(legacySignature, keyPair.PubKey, tempMessage); err != nil || !ok || !legacy {
+		t.Fatalf("expected legacy signature to verify with flag, ok: %v, legacy: %v, err: %v", ok, legacy, err)
+	}
+	if ok, _ := compatible.VerifyBytes(legacySignature, keyPair.PubKey, tempMessage); ok {
+		t.Fatal("expected VerifyBytes to stay strict")
+	}
+
+	signature, _ := bls.signPoint(keyPair, messageG1)
+	if ok, legacy, _ := compatible.VerifyBytesLegacy(signature, keyPair.PubKey, tempMessage); !ok || legacy {
+		t.Fatalf("expected standard signature to verify without legacy path, ok: %v, legacy: %v", ok, legacy)
+	}
+}
@@ -0,0 +1,99 @@
+package bn128_bls
+
+// Memo Of Positive Committee Verifications For Light Clients Re-Verifying Same Blocks, For Example After Restart.
+// Entry Is Keyed By (ConfigFingerprint, CommitteeID, blockHash) And Stores SignatureDigest Of Verified Aggregate Signature,
+// So Hit Requires Same Hashing Configuration, Same Committee, Same Block And Same Signature, Failed Verifications Are Never Stored.
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+)
+
+const committeeIDPrefix = "BN128_BLS_COMMITTEE_ID_"
+
+// Returns Content-Derived Committee Identifier, Keccak256("BN128_BLS_COMMITTEE_ID_" || Sorted PubKeyIdentifiers),
+// Independent Of Member Order And Jacobian Representation. Duplicates Are Kept Since They Change Aggregate PubKey.
+func (bls *BLS) CommitteeID(pubKeysG2 [][3][2]*big.Int) [32]byte {
+	ids := make([][32]byte, len(pubKeysG2))
+	for i, pubKey := range pubKeysG2 {
+		ids[i] = bls.PubKeyIdentifier(pubKey)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return bytes.Compare(ids[i][:], ids[j][:]) < 0
+	})
+	data := make([]byte, 0, len(committeeIDPrefix)+32*len(ids))
+	data = append(data, committeeIDPrefix...)
+	for _, id := range ids {
+		data = append(data, id[:]...)
+	}
+	return keccak256(data)
+}
+
+type memoKey struct {
+	configFingerprint [32]byte
+	committeeID       [32]byte
+	blockHash         [32]byte
+}
+
+// Bounded To `capacity` Entries, Oldest Entry Is Evicted First. Safe For Concurrent Use.
+type VerificationMemo struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[memoKey][32]byte
+	order    []memoKey
+}
+
+func NewVerificationMemo(capacity int) (*VerificationMemo, error) {
+	if capacity < 1 {
+		return nil, fmt.Errorf("invalid memo capacity %d", capacity)
+	}
+	return &VerificationMemo{capacity: capacity, entries: map[memoKey][32]byte{}}, nil
+}
+
+// Verifies `aggSig` Over `blockHash` Signed By Whole Committee Of `cv`, Without Any Pairing If Same Signature
+// Was Already Verified For Same Committee And Block By Instance With Same ConfigFingerprint, So Memo Can Be Shared
+// Between Differently Configured Verifiers. Signature Is Still Validated On Every Call.
+func (memo *VerificationMemo) Verify(cv *CommitteeVerifier, aggSig [3]*big.Int, blockHash [32]byte) (bool, error) {
+	if err := cv.bls.ValidateSignature(aggSig); err != nil {
+		return false, fmt.Errorf("invalid aggSig: %w", err)
+	}
+	configFingerprint, err := cv.bls.ConfigFingerprint()
+	if err != nil {
+		return false, fmt.Errorf("failed to fingerprint config: %w", err)
+	}
+	key := memoKey{configFingerprint: configFingerprint, committeeID: cv.ID(), blockHash: blockHash}
+	signatureDigest := cv.bls.SignatureDigest(aggSig)
+	memo.mu.Lock()
+	cached, hit := memo.entries[key]
+	memo.mu.Unlock()
+	if hit && cached == signatureDigest {
+		return true, nil
+	}
+
+	// Members May Change Since Lookup, Entry Is Stored Under ID Of Members Signature Was Actually Verified Against.
+	committeeID, ok, err := cv.verifyWithID(aggSig, blockHash[:])
+	if err != nil || !ok {
+		return false, err
+	}
+	key.committeeID = committeeID
+	memo.mu.Lock()
+	defer memo.mu.Unlock()
+	if _, exists := memo.entries[key]; !exists {
+		if len(memo.order) == memo.capacity {
+			delete(memo.entries, memo.order[0])
+			memo.order = memo.order[1:]
+		}
+		memo.order = append(memo.order, key)
+	}
+	memo.entries[key] = signatureDigest
+	return true, nil
+}
+
+func (memo *VerificationMemo) Len() int {
+	memo.mu.Lock()
+	defer memo.mu.Unlock()
+	return len(memo.entries)
+}
@@ -0,0 +1,97 @@
+package bn128_bls
+
+import (
+	"math/big"
+	"testing"
+
+	"golang.org/x/crypto/sha3"
+)
+
+func TestVerificationMemo(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	countingBls := NewBls()
+	pairings := 0
+	countingBls.pairingHook = func(n int) {
+		pairings += n
+	}
+	cv, err := countingBls.NewCommitteeVerifier(pubKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	memo, err := NewVerificationMemo(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blockHashes := [2][32]byte{}
+	aggSigs := [2][3]*big.Int{}
+	for i := range blockHashes {
+		hasher := sha3.NewLegacyKeccak256()
+		hasher.Write(tempMessages[i])
+		hasher.Sum(blockHashes[i][:0])
+		aggSigs[i], _ = bls.AggregateSignatures(signAll(t, keyPairs, blockHashes[i][:]))
+	}
+
+	verify := func(i int, expectedPairings int) {
+		t.Helper()
+		pairings = 0
+		ok, err := memo.Verify(cv, aggSigs[i], blockHashes[i])
+		if err != nil || !ok {
+			t.Fatalf("expected block %d to verify, ok: %v, err: %v", i, ok, err)
+		}
+		if pairings != expectedPairings {
+			t.Fatalf("expected %d pairings for block %d, got %d", expectedPairings, i, pairings)
+		}
+	}
+	verify(0, 2)
+	verify(0, 0)
+	verify(1, 2)
+	// Capacity Is 1, So Block 0 Has Been Evicted.
+	verify(0, 2)
+
+	// Shared Memo Must Not Serve Verifier Hashing Under Different DST.
+	otherBls := NewBls()
+	if err := otherBls.SetDST([]byte("OTHER_DST")); err != nil {
+		t.Fatal(err)
+	}
+	otherPairings := 0
+	otherBls.pairingHook = func(n int) {
+		otherPairings += n
+	}
+	otherCv, _ := otherBls.NewCommitteeVerifier(pubKeys)
+	if ok, _ := memo.Verify(otherCv, aggSigs[0], blockHashes[0]); ok || otherPairings == 0 {
+		t.Fatalf("expected differently configured verifier to miss memo, ok: %v, pairings: %d", ok, otherPairings)
+	}
+
+	if ok, _ := memo.Verify(cv, aggSigs[1], blockHashes[0]); ok {
+		t.Fatal("expected signature of other block not to be served from memo")
+	}
+	if memo.Len() != 1 {
+		t.Fatalf("expected memo to hold 1 entry, got %d", memo.Len())
+	}
+
+	reversed := [][3][2]*big.Int{}
+	for i := len(pubKeys) - 1; i >= 0; i-- {
+		reversed = append(reversed, pubKeys[i])
+	}
+	if bls.CommitteeID(reversed) != cv.ID() {
+		t.Fatal("expected committee ID to be independent of member order")
+	}
+	if bls.CommitteeID(pubKeys[1:]) == cv.ID() {
+		t.Fatal("expected different committees to have different IDs")
+	}
+
+	// Members Replaced While Signature Is Being Verified Against Old Ones, Entry Must Not Be Credited To New Committee.
+	countingBls.pairingHook = func(int) {
+		countingBls.pairingHook = nil
+		if err := cv.SetMembers(pubKeys[1:]); err != nil {
+			t.Error(err)
+		}
+	}
+	if ok, err := memo.Verify(cv, aggSigs[1], blockHashes[1]); err != nil || !ok {
+		t.Fatalf("expected block 1 to verify against old members, ok: %v, err: %v", ok, err)
+	}
+	if ok, _ := memo.Verify(cv, aggSigs[1], blockHashes[1]); ok {
+		t.Fatal("expected signature of old members not to be served from memo for new members")
+	}
+}
@@ -0,0 +1,91 @@
+package bn128_bls
+
+// Keccak256 Merkle Commitment Over Signatures, Compatible With OpenZeppelin MerkleProof.
+// Leaf Is Keccak256(SignatureDigest(sig)), Double Hashing Keeps Leaves Distinct From 64 Byte Internal Node Preimages.
+// Internal Node Is Keccak256 Of Sorted Pair Of Children, So Proofs Need No Direction Bits, Odd Node Is Carried Up Unchanged.
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+)
+
+// Returns Merkle Root Committing To `signatures` In Given Order.
+func (bls *BLS) SignaturesMerkleRoot(signatures [][3]*big.Int) ([32]byte, error) {
+	leaves, err := bls.signatureLeaves(signatures)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	for len(leaves) > 1 {
+		leaves = merkleParents(leaves)
+	}
+	return leaves[0], nil
+}
+
+// Returns Inclusion Proof Of signatures[index] Against SignaturesMerkleRoot(signatures).
+func (bls *BLS) SignatureMerkleProof(signatures [][3]*big.Int, index int) ([][32]byte, error) {
+	if index < 0 || index >= len(signatures) {
+		return nil, fmt.Errorf("invalid index %d for %d signatures", index, len(signatures))
+	}
+	leaves, err := bls.signatureLeaves(signatures)
+	if err != nil {
+		return nil, err
+	}
+	proof := [][32]byte{}
+	for len(leaves) > 1 {
+		if sibling := index ^ 1; sibling < len(leaves) {
+			proof = append(proof, leaves[sibling])
+		}
+		leaves = merkleParents(leaves)
+		index /= 2
+	}
+	return proof, nil
+}
+
+// Checks Whether `proof` Proves Inclusion Of `signature` Under `root`.
+func (bls *BLS) VerifySignatureInclusion(signature [3]*big.Int, proof [][32]byte, root [32]byte) bool {
+	if bls.ValidateSignature(signature) != nil {
+		return false
+	}
+	node := signatureLeaf(bls.SignatureDigest(signature))
+	for _, sibling := range proof {
+		node = hashMerklePair(node, sibling)
+	}
+	return node == root
+}
+
+func (bls *BLS) signatureLeaves(signatures [][3]*big.Int) ([][32]byte, error) {
+	if len(signatures) < 1 {
+		return nil, fmt.Errorf("no signature have been passed")
+	}
+	leaves := make([][32]byte, len(signatures))
+	for i, signature := range signatures {
+		if err := bls.ValidateSignature(signature); err != nil {
+			return nil, fmt.Errorf("invalid signature at index %d: %w", i, err)
+		}
+		leaves[i] = signatureLeaf(bls.SignatureDigest(signature))
+	}
+	return leaves, nil
+}
+
+func signatureLeaf(digest [32]byte) [32]byte {
+	return keccak256(digest[:])
+}
+
+func merkleParents(nodes [][32]byte) [][32]byte {
+	parents := make([][32]byte, 0, (len(nodes)+1)/2)
+	for i := 0; i+1 < len(nodes); i += 2 {
+		parents = append(parents, hashMerklePair(nodes[i], nodes[i+1]))
+	}
+	if len(nodes)%2 == 1 {
+		parents = append(parents, nodes[len(nodes)-1])
+	}
+	return parents
+}
+
+func hashMerklePair(a, b [32]byte) [32]byte {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+	return keccak256(a[:], b[:])
+}
@@ -0,0 +1,47 @@
+package bn128_bls
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestSignaturesMerkleRoot(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signatures := [][3]*big.Int{}
+	for i := 0; i < 5; i++ {
+		signature, _ := bls.SignBytes(keyPair, []byte(fmt.Sprintf("committed message %d", i)))
+		signatures = append(signatures, signature)
+	}
+	root, err := bls.SignaturesMerkleRoot(signatures)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, signature := range signatures {
+		proof, err := bls.SignatureMerkleProof(signatures, i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bls.VerifySignatureInclusion(signature, proof, root) {
+			t.Fatalf("expected inclusion proof of signature %d to verify", i)
+		}
+	}
+
+	proof, _ := bls.SignatureMerkleProof(signatures, 2)
+	if bls.VerifySignatureInclusion(signatures[3], proof, root) {
+		t.Fatal("expected proof of other signature to fail")
+	}
+	proof[0][0] ^= 1
+	if bls.VerifySignatureInclusion(signatures[2], proof, root) {
+		t.Fatal("expected tampered proof to fail")
+	}
+
+	single, _ := bls.SignaturesMerkleRoot(signatures[:1])
+	if single != signatureLeaf(bls.SignatureDigest(signatures[0])) {
+		t.Fatal("expected root of single signature to be its leaf")
+	}
+	if _, err := bls.SignaturesMerkleRoot(nil); err == nil {
+		t.Fatal("expected error for empty signature set")
+	}
+}
@@ -0,0 +1,81 @@
+package bn128_bls
+
+// BIP39 Mnemonic Support (English Wordlist Only), Seed Is PBKDF2-HMAC-SHA512(mnemonic, "mnemonic" || passphrase, 2048, 64).
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+//go:embed bip39_english.txt
+var bip39EnglishWordlist string
+
+var (
+	bip39WordsOnce sync.Once
+	bip39Words     []string
+	bip39Indexes   map[string]int
+)
+
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+// Derives KeyPair From BIP39 `mnemonic` And `passphrase` Using NewKeyPairFromSeed, Mnemonic Checksum Is Verified.
+// Passphrase Must Be ASCII, Because Unicode Passphrases Would Need NFKD Normalization Which Is Not Performed Here.
+func (bls *BLS) NewKeyPairFromMnemonic(mnemonic string, passphrase string) (*KeyPair, error) {
+	seed, err := mnemonicToSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return bls.NewKeyPairFromSeed(seed)
+}
+
+func mnemonicToSeed(mnemonic string, passphrase string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if err := checkMnemonic(words); err != nil {
+		return nil, err
+	}
+	for _, c := range passphrase {
+		if c > 0x7f {
+			return nil, fmt.Errorf("passphrase must be ascii")
+		}
+	}
+	return pbkdf2.Key([]byte(strings.Join(words, " ")), []byte("mnemonic"+passphrase), 2048, 64, sha512.New), nil
+}
+
+func checkMnemonic(words []string) error {
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return fmt.Errorf("%w: got %d words, expected 12, 15, 18, 21 or 24", ErrInvalidMnemonic, len(words))
+	}
+	bip39WordsOnce.Do(func() {
+		bip39Words = strings.Fields(bip39EnglishWordlist)
+		bip39Indexes = make(map[string]int, len(bip39Words))
+		for i, word := range bip39Words {
+			bip39Indexes[word] = i
+		}
+	})
+
+	// Every Word Encodes 11 Bits, Last len(words)/3 Bits Are Checksum Taken From SHA256 Of Entropy.
+	bits := new(big.Int)
+	for _, word := range words {
+		index, ok := bip39Indexes[word]
+		if !ok {
+			return fmt.Errorf("%w: unknown word %q", ErrInvalidMnemonic, word)
+		}
+		bits.Lsh(bits, 11).Or(bits, big.NewInt(int64(index)))
+	}
+	checksumBits := uint(len(words) / 3)
+	checksum := new(big.Int).And(bits, big.NewInt(1<<checksumBits-1))
+	entropy := new(big.Int).Rsh(bits, checksumBits).FillBytes(make([]byte, len(words)*4/3))
+	hash := sha256.Sum256(entropy)
+	if uint64(hash[0]>>(8-checksumBits)) != checksum.Uint64() {
+		return fmt.Errorf("%w: checksum mismatch", ErrInvalidMnemonic)
+	}
+	return nil
+}
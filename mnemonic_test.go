@@ -0,0 +1,57 @@
+package bn128_bls
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+const tempMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestNewKeyPairFromMnemonic(t *testing.T) {
+	// BIP39 Test Vector With Passphrase "TREZOR".
+	seed, err := mnemonicToSeed(tempMnemonic, "TREZOR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(seed) != "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04" {
+		t.Fatalf("unexpected seed %x", seed)
+	}
+
+	keyPair, err := bls.NewKeyPairFromMnemonic(tempMnemonic, "TREZOR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, _ := bls.NewKeyPairFromMnemonic(tempMnemonic, "TREZOR")
+	if keyPair.PrivateKey.Cmp(again.PrivateKey) != 0 || !bls.bn128.G2.Equal(keyPair.PubKey, again.PubKey) {
+		t.Fatal("expected mnemonic to produce deterministic keyPair")
+	}
+	if keyPair.PrivateKey.Sign() <= 0 || keyPair.PrivateKey.Cmp(curveOrder) >= 0 {
+		t.Fatal("expected private key in [1, r-1]")
+	}
+	other, _ := bls.NewKeyPairFromMnemonic(tempMnemonic, "")
+	if keyPair.PrivateKey.Cmp(other.PrivateKey) == 0 {
+		t.Fatal("expected passphrase to change keyPair")
+	}
+
+	for _, valid := range []string{
+		"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+	} {
+		if _, err := mnemonicToSeed(valid, ""); err != nil {
+			t.Fatalf("expected valid mnemonic %q, got: %v", valid, err)
+		}
+	}
+	for _, invalid := range []string{
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon notaword",
+		"abandon abandon abandon",
+	} {
+		if _, err := bls.NewKeyPairFromMnemonic(invalid, ""); !errors.Is(err, ErrInvalidMnemonic) {
+			t.Fatalf("expected ErrInvalidMnemonic for %q, got: %v", invalid, err)
+		}
+	}
+	if _, err := bls.NewKeyPairFromSeed(seed[:31]); err == nil {
+		t.Fatal("expected error for short seed")
+	}
+}
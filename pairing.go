@@ -0,0 +1,174 @@
+package bn128_bls
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	bn128PKG "github.com/arnaucube/go-snark/bn128"
+)
+
+// Verification Modes Accepted By EstimatePairings.
+type VerifyMode int
+
+const (
+	// VerifyBytes, VerifySignature And Other Single Signer Checks.
+	VerifyModeSingle VerifyMode = iota
+	// FastAggregateVerify: Many Signers, One Message.
+	VerifyModeFastAggregate
+	// AggregateVerify And AggregateVerifyPoints: Many Signers, Distinct Messages.
+	VerifyModeAggregate
+	// BatchVerify And BatchVerifyAggregates: Many Independent Relations Checked At Once.
+	VerifyModeBatch
+	// VerifyBatchSameKey: Many Messages Signed By One Key.
+	VerifyModeBatchSameKey
+)
+
+// Returns Number Of Pairings Verification In `mode` Performs For `n` Inputs (Signers, Messages Or Triples),
+// So Gateways Can Reject Requests Exceeding Pairing Budget Before Doing The Work.
+func EstimatePairings(mode VerifyMode, n int) (int, error) {
+	if n < 1 {
+		return 0, fmt.Errorf("invalid input count %d", n)
+	}
+	switch mode {
+	case VerifyModeSingle, VerifyModeFastAggregate, VerifyModeBatchSameKey:
+		return 2, nil
+	case VerifyModeAggregate, VerifyModeBatch:
+		return n + 1, nil
+	default:
+		return 0, fmt.Errorf("unknown verify mode %d", mode)
+	}
+}
+
+// Checks Whether Product Of Pairings e(g1Points[i], g2Points[i]) Equals One, Same Condition As EIP-197 Precompile.
+func (bls *BLS) pairingCheck(g1Points [][3]*big.Int, g2Points [][3][2]*big.Int) bool {
+	// Background Context Is Never Done.
+	ok, _ := bls.pairingCheckContext(context.Background(), g1Points, g2Points)
+	return ok
+}
+
+// Same As pairingCheck But Returns ctx.Err() If `ctx` Is Done Before Each Pair Is Processed.
+func (bls *BLS) pairingCheckContext(ctx context.Context, g1Points [][3]*big.Int, g2Points [][3][2]*big.Int) (bool, error) {
+	g2Lines := make([]bn128PKG.AteG2Precomp, len(g2Points))
+	for i := range g2Points {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		if bls.bn128.G1.IsZero(g1Points[i]) || bls.bn128.G2.IsZero(g2Points[i]) {
+			continue
+		}
+		g2Lines[i] = bls.precomputeG2Lines(g2Points[i])
+	}
+	return bls.pairingCheckLinesContext(ctx, g1Points, g2Lines)
+}
+
+// Same As pairingCheck With G2 Side Given As Precomputed Miller Lines (See precomputeG2Lines),
+// Miller Loops Are Multiplied Together So Final Exponentiation Runs Only Once.
+func (bls *BLS) pairingCheckLines(g1Points [][3]*big.Int, g2Lines []bn128PKG.AteG2Precomp) bool {
+	ok, _ := bls.pairingCheckLinesContext(context.Background(), g1Points, g2Lines)
+	return ok
+}
+
+func (bls *BLS) pairingCheckLinesContext(ctx context.Context, g1Points [][3]*big.Int, g2Lines []bn128PKG.AteG2Precomp) (bool, error) {
+	bls.used.Store(true)
+	if bls.pairingHook != nil {
+		bls.pairingHook(len(g1Points))
+	}
+	product := bls.bn128.Fq12.One()
+	for i := range g1Points {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		// e(O, Q) = e(P, O) = 1, Pairs With Identity Contribute Nothing.
+		if bls.bn128.G1.IsZero(g1Points[i]) || g2Lines[i].Coeffs == nil {
+			continue
+		}
+		affine := bls.bn128.G1.Affine(g1Points[i])
+		g1Pre := bn128PKG.AteG1Precomp{Px: affine[0], Py: affine[1]}
+		product = bls.bn128.Fq12.Mul(product, bls.bn128.MillerLoop(g1Pre, g2Lines[i]))
+	}
+	return bls.bn128.Fq12.Equal(bls.bn128.Fq12.Exp(product, bls.bn128.FinalExp), bls.bn128.Fq12.One()), nil
+}
+
+// Computes Line Coefficients Of Optimal Ate Miller Loop For Non-Identity G2 Point, Which Depend Only On That Point,
+// So They Can Be Cached And Reused Across Pairings. Mirrors Unexported preComputeG2 Of bn128 Package.
+func (bls *BLS) precomputeG2Lines(point [3][2]*big.Int) bn128PKG.AteG2Precomp {
+	fq2 := bls.bn128.Fq2
+	affine := bls.bn128.G2.Affine(point)
+	lines := bn128PKG.AteG2Precomp{Qx: affine[0], Qy: affine[1]}
+	r := [3][2]*big.Int{fq2.Copy(affine[0]), fq2.Copy(affine[1]), fq2.One()}
+	var coeffs bn128PKG.EllCoeffs
+	for i := bls.bn128.LoopCount.BitLen() - 2; i >= 0; i-- {
+		coeffs, r = bls.doublingStep(r)
+		lines.Coeffs = append(lines.Coeffs, coeffs)
+		if bls.bn128.LoopCount.Bit(i) == 1 {
+			coeffs, r = bls.mixedAdditionStep(affine, r)
+			lines.Coeffs = append(lines.Coeffs, coeffs)
+		}
+	}
+
+	q1 := bls.bn128.G2.Affine(bls.psiG2(affine))
+	q2 := bls.bn128.G2.Affine(bls.psiG2(q1))
+	if bls.bn128.LoopCountNeg {
+		r[1] = fq2.Neg(r[1])
+	}
+	q2[1] = fq2.Neg(q2[1])
+	coeffs, r = bls.mixedAdditionStep(q1, r)
+	lines.Coeffs = append(lines.Coeffs, coeffs)
+	coeffs, _ = bls.mixedAdditionStep(q2, r)
+	lines.Coeffs = append(lines.Coeffs, coeffs)
+	return lines
+}
+
+func (bls *BLS) doublingStep(current [3][2]*big.Int) (bn128PKG.EllCoeffs, [3][2]*big.Int) {
+	fq2 := bls.bn128.Fq2
+	x, y, z := current[0], current[1], current[2]
+
+	a := fq2.MulScalar(fq2.Mul(x, y), bls.bn128.TwoInv)
+	b := fq2.Square(y)
+	c := fq2.Square(z)
+	d := fq2.Add(c, fq2.Add(c, c))
+	e := fq2.Mul(bls.bn128.TwistCoefB, d)
+	f := fq2.Add(e, fq2.Add(e, e))
+	g := fq2.MulScalar(fq2.Add(b, f), bls.bn128.TwoInv)
+	h := fq2.Sub(fq2.Square(fq2.Add(y, z)), fq2.Add(b, c))
+	i := fq2.Sub(e, b)
+	j := fq2.Square(x)
+	eSquare := fq2.Square(e)
+
+	next := [3][2]*big.Int{
+		fq2.Mul(a, fq2.Sub(b, f)),
+		fq2.Sub(fq2.Sub(fq2.Square(g), eSquare), fq2.Add(eSquare, eSquare)),
+		fq2.Mul(b, h),
+	}
+	return bn128PKG.EllCoeffs{
+		Ell0:  fq2.Mul(i, bls.bn128.Twist),
+		EllVW: fq2.Neg(h),
+		EllVV: fq2.Add(j, fq2.Add(j, j)),
+	}, next
+}
+
+func (bls *BLS) mixedAdditionStep(base, current [3][2]*big.Int) (bn128PKG.EllCoeffs, [3][2]*big.Int) {
+	fq2 := bls.bn128.Fq2
+	x1, y1, z1 := current[0], current[1], current[2]
+	x2, y2 := base[0], base[1]
+
+	d := fq2.Sub(x1, fq2.Mul(x2, z1))
+	e := fq2.Sub(y1, fq2.Mul(y2, z1))
+	f := fq2.Square(d)
+	g := fq2.Square(e)
+	h := fq2.Mul(d, f)
+	i := fq2.Mul(x1, f)
+	j := fq2.Sub(fq2.Add(h, fq2.Mul(z1, g)), fq2.Add(i, i))
+
+	next := [3][2]*big.Int{
+		fq2.Mul(d, j),
+		fq2.Sub(fq2.Mul(e, fq2.Sub(i, j)), fq2.Mul(h, y1)),
+		fq2.Mul(z1, h),
+	}
+	return bn128PKG.EllCoeffs{
+		Ell0:  fq2.Mul(bls.bn128.Twist, fq2.Sub(fq2.Mul(e, x2), fq2.Mul(d, y2))),
+		EllVW: d,
+		EllVV: fq2.Neg(e),
+	}, next
+}
@@ -0,0 +1,57 @@
+package bn128_bls
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestEstimatePairings(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	keyPairs, pubKeys = keyPairs[:2], pubKeys[:2]
+	messages := tempMessages[:2]
+	signatures := [][3]*big.Int{}
+	for i, keyPair := range keyPairs {
+		signature, _ := bls.SignBytes(keyPair, messages[i])
+		signatures = append(signatures, signature)
+	}
+	aggSig, _ := bls.AggregateSignatures(signatures)
+	sameMessageAggSig, _ := bls.AggregateSignatures(signAll(t, keyPairs, tempMessage))
+
+	countingBls := NewBls()
+	pairings := 0
+	countingBls.pairingHook = func(n int) {
+		pairings += n
+	}
+
+	for _, c := range []struct {
+		mode   VerifyMode
+		n      int
+		verify func() (bool, error)
+	}{
+		{VerifyModeSingle, 1, func() (bool, error) { return countingBls.VerifyBytes(signatures[0], pubKeys[0], messages[0]) }},
+		{VerifyModeFastAggregate, 2, func() (bool, error) {
+			return countingBls.FastAggregateVerify(sameMessageAggSig, pubKeys, tempMessage)
+		}},
+		{VerifyModeAggregate, 2, func() (bool, error) { return countingBls.AggregateVerify(aggSig, pubKeys, messages) }},
+		{VerifyModeBatch, 2, func() (bool, error) {
+			return countingBls.BatchVerify([]VerifyTriple{
+				{Signature: signatures[0], PubKey: pubKeys[0], Message: messages[0]},
+				{Signature: signatures[1], PubKey: pubKeys[1], Message: messages[1]},
+			})
+		}},
+	} {
+		pairings = 0
+		ok, err := c.verify()
+		if err != nil || !ok {
+			t.Fatalf("mode %d: expected verification to succeed, ok: %v, err: %v", c.mode, ok, err)
+		}
+		estimate, err := EstimatePairings(c.mode, c.n)
+		if err != nil || estimate != pairings {
+			t.Fatalf("mode %d: estimated %d pairings, performed %d, err: %v", c.mode, estimate, pairings, err)
+		}
+	}
+
+	if _, err := EstimatePairings(VerifyMode(99), 1); err == nil {
+		t.Fatal("expected error for unknown mode")
+	}
+}
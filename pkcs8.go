@@ -0,0 +1,109 @@
+package bn128_bls
+
+// PKCS#8 (RFC 5208 PrivateKeyInfo) Encoding Of Private Keys For Generic Key Stores, Same Layout As RFC 8410 Uses For Ed25519:
+//
+//	PrivateKeyInfo ::= SEQUENCE {
+//	  version             INTEGER (0),
+//	  privateKeyAlgorithm SEQUENCE { algorithm OBJECT IDENTIFIER },
+//	  privateKey          OCTET STRING (DER Of OCTET STRING Holding 32 Byte Big-Endian Scalar)
+//	}
+//
+// Algorithm OID Is 2.25.337424654276832955722642214441404894842, Derived From UUID fdd99fe3-77a4-4613-866f-617a38788e7a
+// Under UUID Arc Of ITU-T X.667, It Identifies BLS Over BN254 (alt_bn128) With PubKeys On G2 And Signatures On G1.
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var ErrInvalidPKCS8 = errors.New("invalid pkcs8 private key")
+
+// 2.25.337424654276832955722642214441404894842, See File Comment.
+var pkcs8OIDArc, _ = new(big.Int).SetString("337424654276832955722642214441404894842", 10)
+
+// DER Encoding Of Algorithm OID, asn1.ObjectIdentifier Can Not Hold 128 Bit Arc So It Is Encoded By Hand.
+var pkcs8OID = encodeUUIDOID(pkcs8OIDArc)
+
+type pkcs8PrivateKey struct {
+	Version    int
+	Algorithm  pkcs8Algorithm
+	PrivateKey []byte
+}
+
+type pkcs8Algorithm struct {
+	Algorithm asn1.RawValue
+}
+
+// Encodes Private Key Of `keyPair` Reduced Modulo R, Keys Equal Modulo R Have Same Encoding.
+func (bls *BLS) ExportPKCS8(keyPair *KeyPair) ([]byte, error) {
+	if keyPair.IsDestroyed() {
+		return nil, ErrKeyDestroyed
+	}
+	privateKey := new(big.Int).Mod(keyPair.PrivateKey, curveOrder)
+	if privateKey.Sign() == 0 {
+		return nil, fmt.Errorf("private key is zero modulo r")
+	}
+	scalar := ToFixed32(privateKey)
+	inner, err := asn1.Marshal(scalar[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode private key: %v", err)
+	}
+	return asn1.Marshal(pkcs8PrivateKey{
+		Algorithm:  pkcs8Algorithm{Algorithm: asn1.RawValue{FullBytes: pkcs8OID}},
+		PrivateKey: inner,
+	})
+}
+
+// Decodes Key Produced By ExportPKCS8, Structure Must Match Exactly And Scalar Must Be In [1, R-1].
+func (bls *BLS) ParsePKCS8(der []byte) (*KeyPair, error) {
+	var info pkcs8PrivateKey
+	rest, err := asn1.Unmarshal(der, &info)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPKCS8, err)
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: %d trailing bytes", ErrInvalidPKCS8, len(rest))
+	}
+	if info.Version != 0 {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidPKCS8, info.Version)
+	}
+	if !bytes.Equal(info.Algorithm.Algorithm.FullBytes, pkcs8OID) {
+		return nil, fmt.Errorf("%w: algorithm is not bn254 bls", ErrInvalidPKCS8)
+	}
+	var scalar []byte
+	rest, err = asn1.Unmarshal(info.PrivateKey, &scalar)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPKCS8, err)
+	}
+	if len(rest) != 0 || len(scalar) != coordinateSize {
+		return nil, fmt.Errorf("%w: private key must be %d bytes", ErrInvalidPKCS8, coordinateSize)
+	}
+	privateKey := new(big.Int).SetBytes(scalar)
+	if !IsValidPrivateKey(privateKey) {
+		return nil, fmt.Errorf("%w: private key is not in [1, r-1]", ErrInvalidPKCS8)
+	}
+	return &KeyPair{
+		PrivateKey: privateKey,
+		PubKey:     bls.mulBaseG2(privateKey),
+		PubKeyG1:   bls.mulBaseG1(privateKey),
+	}, nil
+}
+
+// DER Of OID 2.25.`arc`, First Two Arcs Are Packed Into 2*40+25, Remaining Arc Is Base-128 With Continuation Bits.
+func encodeUUIDOID(arc *big.Int) []byte {
+	var base128 []byte
+	n := new(big.Int).Set(arc)
+	for first := true; first || n.Sign() > 0; first = false {
+		digit := byte(new(big.Int).And(n, big.NewInt(0x7f)).Uint64())
+		if !first {
+			digit |= 0x80
+		}
+		base128 = append([]byte{digit}, base128...)
+		n.Rsh(n, 7)
+	}
+	content := append([]byte{2*40 + 25}, base128...)
+	return append([]byte{0x06, byte(len(content))}, content...)
+}
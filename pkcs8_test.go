@@ -0,0 +1,58 @@
+package bn128_bls
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestPKCS8(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	der, err := bls.ExportPKCS8(keyPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := bls.ParsePKCS8(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Test Key Exceeds R, So It Comes Back Reduced.
+	if parsed.PrivateKey.Cmp(new(big.Int).Mod(keyPair.PrivateKey, Order())) != 0 || !bls.bn128.G2.Equal(parsed.PubKey, keyPair.PubKey) {
+		t.Fatal("round trip changed key")
+	}
+
+	// Generic Parser Must Agree On OID, Arc Exceeds asn1.ObjectIdentifier So Only Prefix Is Compared.
+	var oid asn1.RawValue
+	if _, err := asn1.Unmarshal(pkcs8OID, &oid); err != nil || oid.Tag != asn1.TagOID {
+		t.Fatalf("algorithm OID is not valid DER, err: %v", err)
+	}
+	if oid.Bytes[0] != 2*40+25 {
+		t.Fatal("expected OID under 2.25 arc")
+	}
+}
+
+func TestParsePKCS8Malformed(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	der, _ := bls.ExportPKCS8(keyPair)
+
+	wrongOID := append([]byte{}, der...)
+	wrongOID[bytes.Index(der, pkcs8OID)+len(pkcs8OID)-1] ^= 1
+	zeroKey, _ := asn1.Marshal(pkcs8PrivateKey{
+		Algorithm:  pkcs8Algorithm{Algorithm: asn1.RawValue{FullBytes: pkcs8OID}},
+		PrivateKey: append([]byte{0x04, 0x20}, make([]byte, 32)...),
+	})
+	cases := map[string][]byte{
+		"empty":     {},
+		"truncated": der[:len(der)-1],
+		"trailing":  append(append([]byte{}, der...), 0),
+		"wrong oid": wrongOID,
+		"zero key":  zeroKey,
+	}
+	for name, input := range cases {
+		if _, err := bls.ParsePKCS8(input); !errors.Is(err, ErrInvalidPKCS8) {
+			t.Fatalf("%s: expected ErrInvalidPKCS8, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package bn128_bls
+
+// Proofs Of Possession Guarding Against Rogue-Key Attacks On Same-Message Aggregation (See FastAggregateVerify).
+// PoP Is Signature Over PubKeyToBytes(pubKey) Hashed To G1 Under Separate PoP DST "BN128_BLS_POP_" || DST
+// (As PopProve Of draft-irtf-cfrg-bls-signature Section 3.3), Since Message Prefix Under Signature DST Would Let
+// Anyone Who Gets Caller-Chosen Bytes Signed With SignBytes Obtain Valid PoP. PoP DST Longer Than 255 Bytes Is
+// Replaced By Keccak256("H2C-OVERSIZE-DST-" || PoP DST), As In RFC 9380 Section 5.3.3.
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+)
+
+const popDSTPrefix = "BN128_BLS_POP_"
+
+type KeyWithPoP struct {
+	PubKey [3][2]*big.Int
+	PoP    [3]*big.Int
+}
+
+// Returns Proof That Holder Of `keyPair` Knows Private Key Of keyPair.PubKey.
+func (bls *BLS) ProvePossession(keyPair *KeyPair) ([3]*big.Int, error) {
+	if keyPair == nil {
+		return [3]*big.Int{}, fmt.Errorf("keyPair is nil")
+	}
+	return bls.signPoint(keyPair, bls.hashPoP(bls.PubKeyToBytes(keyPair.PubKey)))
+}
+
+// Verifies PoP Produced By ProvePossession.
+func (bls *BLS) VerifyPossession(pubKeyG2 [3][2]*big.Int, pop [3]*big.Int) (bool, error) {
+	if err := bls.ValidatePubKey(pubKeyG2); err != nil {
+		return false, fmt.Errorf("invalid pubKey: %w", err)
+	}
+	if err := bls.ValidateSignature(pop); err != nil {
+		return false, fmt.Errorf("invalid pop: %w", err)
+	}
+	return bls.verifyPoint(pop, pubKeyG2, bls.hashPoP(bls.PubKeyToBytes(pubKeyG2))), nil
+}
+
+// Checks Every Member PoP And That Member PubKeys Sum To `aggPubKeyG2`, So Aggregate Is Safe Against Rogue Keys.
+// PoPs Are Checked Together With BatchVerify, Using len(members)+1 Pairings.
+func (bls *BLS) VerifyHonestAggregate(aggPubKeyG2 [3][2]*big.Int, members []KeyWithPoP) (bool, error) {
+	if len(members) < 1 {
+		return false, fmt.Errorf("no member have been passed")
+	}
+	if err := bls.ValidatePubKey(aggPubKeyG2); err != nil {
+		return false, fmt.Errorf("invalid aggPubKey: %w", err)
+	}
+	pubKeysG2 := make([][3][2]*big.Int, len(members))
+	triples := make([]VerifyTriple, len(members))
+	for i, member := range members {
+		if err := bls.ValidatePubKey(member.PubKey); err != nil {
+			return false, fmt.Errorf("invalid pubKey at index %d: %w", i, err)
+		}
+		pubKeysG2[i] = member.PubKey
+		triples[i] = VerifyTriple{Signature: member.PoP, PubKey: member.PubKey, Message: bls.PubKeyToBytes(member.PubKey)}
+	}
+	aggregated, err := bls.aggregatePubKeysG2(pubKeysG2)
+	if err != nil {
+		return false, fmt.Errorf("failed to aggregate pubKeys: %v", err)
+	}
+	if !bls.bn128.G2.Equal(aggregated, aggPubKeyG2) {
+		return false, nil
+	}
+	return bls.batchVerifyContext(context.Background(), triples, bls.hashPoP)
+}
+
+func (bls *BLS) hashPoP(message []byte) [3]*big.Int {
+	return bls.hashToG1ForPurpose(popDSTPrefix, message)
+}
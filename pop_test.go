@@ -0,0 +1,69 @@
+package bn128_bls
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVerifyHonestAggregate(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	keyPairs, pubKeys = keyPairs[:3], pubKeys[:3]
+	members := make([]KeyWithPoP, len(keyPairs))
+	for i, keyPair := range keyPairs {
+		pop, err := bls.ProvePossession(keyPair)
+		if err != nil {
+			t.Fatal(err)
+		}
+		members[i] = KeyWithPoP{PubKey: keyPair.PubKey, PoP: pop}
+	}
+	if ok, err := bls.VerifyPossession(members[0].PubKey, members[0].PoP); err != nil || !ok {
+		t.Fatalf("expected PoP to verify, ok: %v, err: %v", ok, err)
+	}
+	aggPubKeyG2, _ := bls.aggregatePubKeysG2(pubKeys)
+
+	ok, err := bls.VerifyHonestAggregate(aggPubKeyG2, members)
+	if err != nil || !ok {
+		t.Fatalf("expected honest aggregate to verify, ok: %v, err: %v", ok, err)
+	}
+
+	// Aggregate Arithmetic Still Holds, But Member 1 Carries PoP Of Member 2.
+	forged := append([]KeyWithPoP{}, members...)
+	forged[1].PoP = members[2].PoP
+	ok, err = bls.VerifyHonestAggregate(aggPubKeyG2, forged)
+	if err != nil || ok {
+		t.Fatalf("expected invalid PoP to fail, ok: %v, err: %v", ok, err)
+	}
+
+	ok, err = bls.VerifyHonestAggregate(aggPubKeyG2, members[:2])
+	if err != nil || ok {
+		t.Fatalf("expected mismatching aggregate to fail, ok: %v, err: %v", ok, err)
+	}
+}
+
+// Signing Service Which Signs Caller-Chosen Bytes With SignBytes Must Not Be Usable As PoP Oracle.
+func TestPoPDomainSeparation(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	for _, message := range [][]byte{
+		bls.PubKeyToBytes(keyPair.PubKey),
+		append([]byte("BN128_BLS_POP_"), bls.PubKeyToBytes(keyPair.PubKey)...),
+	} {
+		signature, _ := bls.SignBytes(keyPair, message)
+		if ok, _ := bls.VerifyPossession(keyPair.PubKey, signature); ok {
+			t.Fatal("expected ordinary signature not to verify as PoP")
+		}
+	}
+	pop, _ := bls.ProvePossession(keyPair)
+	if ok, _ := bls.VerifyBytes(pop, keyPair.PubKey, bls.PubKeyToBytes(keyPair.PubKey)); ok {
+		t.Fatal("expected PoP not to verify as ordinary signature")
+	}
+
+	// PoP DST Exceeds 255 Bytes And Is Hashed Down.
+	longDSTBls := NewBls()
+	if err := longDSTBls.SetDST([]byte(strings.Repeat("D", 250))); err != nil {
+		t.Fatal(err)
+	}
+	pop, _ = longDSTBls.ProvePossession(keyPair)
+	if ok, err := longDSTBls.VerifyPossession(keyPair.PubKey, pop); err != nil || !ok {
+		t.Fatalf("expected PoP under long DST to verify, ok: %v, err: %v", ok, err)
+	}
+}
@@ -0,0 +1,87 @@
+package bn128_bls
+
+// Lazily Populated Precomputed Tables, Every Table Is Built Exactly Once Behind Its Own sync.Once,
+// So Single *BLS Can Be Shared Across Goroutines And First Use From Any Of Them Is Race-Free.
+// Tables Are Read-Only After Initialization.
+
+import (
+	"math/big"
+	"sync"
+
+	bn128PKG "github.com/arnaucube/go-snark/bn128"
+)
+
+type precomputedTables struct {
+	g1BaseOnce sync.Once
+	g1Base     [][3]*big.Int
+
+	g2BaseOnce sync.Once
+	g2Base     [][3][2]*big.Int
+
+	g2GeneratorLinesOnce sync.Once
+	g2GeneratorLines     bn128PKG.AteG2Precomp
+
+	svdwOnce      sync.Once
+	svdwConstants [3]*big.Int
+}
+
+// Returns [2^i]G1 For i In [0, bitlen(R)), Built On First Use.
+func (bls *BLS) g1BaseTable() [][3]*big.Int {
+	bls.tables.g1BaseOnce.Do(func() {
+		table := make([][3]*big.Int, curveOrder.BitLen())
+		table[0] = bls.bn128.G1.G
+		for i := 1; i < len(table); i++ {
+			table[i] = bls.bn128.G1.Double(table[i-1])
+		}
+		bls.tables.g1Base = table
+	})
+	return bls.tables.g1Base
+}
+
+// Returns [2^i]G2 For i In [0, bitlen(R)), Built On First Use.
+func (bls *BLS) g2BaseTable() [][3][2]*big.Int {
+	bls.tables.g2BaseOnce.Do(func() {
+		table := make([][3][2]*big.Int, curveOrder.BitLen())
+		table[0] = bls.bn128.G2.G
+		for i := 1; i < len(table); i++ {
+			table[i] = bls.bn128.G2.Double(table[i-1])
+		}
+		bls.tables.g2Base = table
+	})
+	return bls.tables.g2Base
+}
+
+// Returns Miller Lines Of G2 Generator (See precomputeG2Lines), Built On First Use.
+func (bls *BLS) g2GeneratorLinesTable() bn128PKG.AteG2Precomp {
+	bls.tables.g2GeneratorLinesOnce.Do(func() {
+		bls.tables.g2GeneratorLines = bls.precomputeG2Lines(bls.bn128.G2.G)
+	})
+	return bls.tables.g2GeneratorLines
+}
+
+// Computes [scalar]G1 Using Table Of Doublings, Skipping Doublings Of Double-And-Add.
+func (bls *BLS) mulBaseG1(scalar *big.Int) [3]*big.Int {
+	table := bls.g1BaseTable()
+	k := new(big.Int).Mod(scalar, curveOrder)
+	result := bls.zeroG1()
+	for i := 0; i < k.BitLen(); i++ {
+		if k.Bit(i) == 1 {
+			result = bls.bn128.G1.Add(result, table[i])
+		}
+	}
+	// Single Set Bit Would Return Table Entry Itself.
+	return CloneG1(result)
+}
+
+// Computes [scalar]G2 Using Table Of Doublings, Skipping Doublings Of Double-And-Add.
+func (bls *BLS) mulBaseG2(scalar *big.Int) [3][2]*big.Int {
+	table := bls.g2BaseTable()
+	k := new(big.Int).Mod(scalar, curveOrder)
+	result := bls.bn128.G2.Zero()
+	for i := 0; i < k.BitLen(); i++ {
+		if k.Bit(i) == 1 {
+			result = bls.bn128.G2.Add(result, table[i])
+		}
+	}
+	return CloneG2(result)
+}
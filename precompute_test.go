@@ -0,0 +1,47 @@
+package bn128_bls
+
+import (
+	"math/big"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// Run With -race: Every Goroutine Triggers First Use Of Every Table On Fresh Instance.
+func TestPrecomputedTablesConcurrentInit(t *testing.T) {
+	sharedBls := NewBls()
+	scalar, _ := new(big.Int).SetString("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f", 16)
+	expectedG1 := sharedBls.bn128.G1.MulScalar(sharedBls.bn128.G1.G, scalar)
+	expectedG2 := sharedBls.bn128.G2.MulScalar(sharedBls.bn128.G2.G, scalar)
+	expectedLines := sharedBls.precomputeG2Lines(sharedBls.bn128.G2.G)
+	// Constants Are Built On Separate Instance So Shared One Still Initializes Its Own Table Concurrently.
+	expectedSVDW := NewBls().svdwConstantsTable()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !sharedBls.bn128.G1.Equal(sharedBls.mulBaseG1(scalar), expectedG1) {
+				t.Error("fixed-base G1 multiplication mismatch")
+			}
+			if !sharedBls.bn128.G2.Equal(sharedBls.mulBaseG2(scalar), expectedG2) {
+				t.Error("fixed-base G2 multiplication mismatch")
+			}
+			if !reflect.DeepEqual(sharedBls.g2GeneratorLinesTable(), expectedLines) {
+				t.Error("generator Miller lines mismatch")
+			}
+			if svdw := sharedBls.svdwConstantsTable(); svdw[0].Cmp(expectedSVDW[0]) != 0 || svdw[1].Cmp(expectedSVDW[1]) != 0 || svdw[2].Cmp(expectedSVDW[2]) != 0 {
+				t.Error("SVDW constants mismatch")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(sharedBls.g1BaseTable()) != curveOrder.BitLen() || len(sharedBls.g2BaseTable()) != curveOrder.BitLen() {
+		t.Fatal("unexpected table length")
+	}
+	if !sharedBls.bn128.G2.IsZero(sharedBls.mulBaseG2(curveOrder)) {
+		t.Fatal("expected [R]G2 to be identity")
+	}
+}
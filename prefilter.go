@@ -0,0 +1,36 @@
+package bn128_bls
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Sets Longest `message` Accepted By PrefilterSignature, Zero (Default) Means No Limit.
+// Returns ErrConfigLocked Once Instance Has Been Used To Sign Or Verify.
+func (bls *BLS) SetPrefilterMaxMessageSize(size int) error {
+	if bls.used.Load() {
+		return ErrConfigLocked
+	}
+	if size < 0 {
+		return fmt.Errorf("invalid prefilter max message size %d, it must not be negative", size)
+	}
+	bls.prefilterMaxMessageSize = size
+	return nil
+}
+
+// First Stage Of Two-Stage Verification For Gateways Under Spam, Runs Only Cheap Checks Without Any Pairing:
+// `message` Must Not Be Longer Than Limit Set By SetPrefilterMaxMessageSize, Signature Must Pass ValidateSignature
+// And `signerPubKey` Must Pass ValidatePubKey (Including G2 Subgroup Check).
+// Returning True Only Means Signature Is Plausible, It Must Still Be Verified With VerifyBytes.
+func (bls *BLS) PrefilterSignature(signature [3]*big.Int, signerPubKey [3][2]*big.Int, message []byte) (bool, error) {
+	if bls.prefilterMaxMessageSize > 0 && len(message) > bls.prefilterMaxMessageSize {
+		return false, fmt.Errorf("message is too long, got %d bytes, max is %d", len(message), bls.prefilterMaxMessageSize)
+	}
+	if err := bls.ValidateSignature(signature); err != nil {
+		return false, fmt.Errorf("invalid signature: %w", err)
+	}
+	if err := bls.ValidatePubKey(signerPubKey); err != nil {
+		return false, fmt.Errorf("invalid signerPubKey: %w", err)
+	}
+	return true, nil
+}
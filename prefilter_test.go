@@ -0,0 +1,57 @@
+package bn128_bls
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestPrefilterSignature(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+	countingBls := NewBls()
+	if err := countingBls.SetPrefilterMaxMessageSize(-1); err == nil {
+		t.Fatal("expected error for negative max message size")
+	}
+	if err := countingBls.SetPrefilterMaxMessageSize(len(tempMessage)); err != nil {
+		t.Fatal(err)
+	}
+	pairings := 0
+	countingBls.pairingHook = func(n int) {
+		pairings += n
+	}
+
+	unreduced := CloneG1(signature)
+	unreduced[0] = new(big.Int).Add(unreduced[0], bls.bn128.Q)
+	for name, c := range map[string]struct {
+		signature [3]*big.Int
+		pubKey    [3][2]*big.Int
+	}{
+		"off curve signature": {[3]*big.Int{big.NewInt(1), big.NewInt(3), big.NewInt(1)}, keyPair.PubKey},
+		"infinity signature":  {bls.zeroG1(), keyPair.PubKey},
+		"unreduced signature": {unreduced, keyPair.PubKey},
+		"off subgroup pubKey": {signature, randomTwistPoint(t)},
+		"infinity pubKey":     {signature, bls.bn128.G2.Zero()},
+	} {
+		if ok, err := countingBls.PrefilterSignature(c.signature, c.pubKey, tempMessage); ok || err == nil {
+			t.Fatalf("expected prefilter to reject %s", name)
+		}
+	}
+	longMessage := append(append([]byte{}, tempMessage...), 0)
+	if ok, err := countingBls.PrefilterSignature(signature, keyPair.PubKey, longMessage); ok || err == nil {
+		t.Fatal("expected prefilter to reject message over max size")
+	}
+	if pairings != 0 {
+		t.Fatalf("expected prefilter to do no pairing, got %d", pairings)
+	}
+
+	ok, err := countingBls.PrefilterSignature(signature, keyPair.PubKey, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected valid signature to pass prefilter, ok: %v, err: %v", ok, err)
+	}
+	if pairings != 0 {
+		t.Fatalf("expected prefilter to do no pairing, got %d", pairings)
+	}
+	if ok, _ := countingBls.VerifyBytes(signature, keyPair.PubKey, tempMessage); !ok || pairings == 0 {
+		t.Fatalf("expected full verification to pass with pairings, ok: %v, pairings: %d", ok, pairings)
+	}
+}
@@ -0,0 +1,112 @@
+package bn128_bls
+
+// Portable Configuration Profile, So Signer And Verifier Can Provably Run Same Settings By Exchanging One JSON Blob.
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	profileVersion = 1
+
+	profileHashKeccak256      = "keccak256"
+	profileMapTryAndIncrement = "try-and-increment"
+	profileMapSVDWViaFr       = "svdw-scalar-field"
+	profileHashXMDSHA256      = "xmd-sha256"
+	profileMapSVDW            = "svdw"
+	profileByteOrderEIP197    = "big-endian-eip197"
+)
+
+type profile struct {
+	Version        int    `json:"version"`
+	DST            string `json:"dst"`
+	Hash           string `json:"hash"`
+	MapToCurve     string `json:"mapToCurve"`
+	ByteOrder      string `json:"byteOrder"`
+	PrivateKeySize int    `json:"privateKeySize,omitempty"`
+}
+
+// Serializes DST, Hash Function, Map-To-Curve Variant (Selected By SetHashToCurve Or MapViaScalarField), Byte Order And Private Key Size As Deterministic JSON.
+func (bls *BLS) ExportProfile() ([]byte, error) {
+	p := bls.hashingProfile()
+	p.PrivateKeySize = bls.privateKeySize
+	return json.Marshal(p)
+}
+
+// Returns Profile Without PrivateKeySize, Which Does Not Affect Hashing.
+func (bls *BLS) hashingProfile() profile {
+	hash, mapToCurve := profileHashKeccak256, profileMapTryAndIncrement
+	switch {
+	case bls.hashToCurve == HashToCurveSVDW:
+		hash, mapToCurve = profileHashXMDSHA256, profileMapSVDW
+	case bls.mapViaScalarField:
+		mapToCurve = profileMapSVDWViaFr
+	}
+	return profile{
+		Version:    profileVersion,
+		DST:        hex.EncodeToString(bls.dst),
+		Hash:       hash,
+		MapToCurve: mapToCurve,
+		ByteOrder:  profileByteOrderEIP197,
+	}
+}
+
+// Reconstructs Instance Configured As Described By Profile From ExportProfile, Unknown Values Are Rejected.
+func LoadProfile(data []byte) (*BLS, error) {
+	p := profile{}
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("failed to parse profile: %v", err)
+	}
+	if p.Version != profileVersion {
+		return nil, fmt.Errorf("unsupported profile version %d", p.Version)
+	}
+	if p.Hash != profileHashKeccak256 && p.Hash != profileHashXMDSHA256 {
+		return nil, fmt.Errorf("unsupported profile hash %q", p.Hash)
+	}
+	if p.ByteOrder != profileByteOrderEIP197 {
+		return nil, fmt.Errorf("unsupported profile byte order %q", p.ByteOrder)
+	}
+	if p.PrivateKeySize < 2 {
+		return nil, fmt.Errorf("invalid profile private key size %d", p.PrivateKeySize)
+	}
+	dst, err := hex.DecodeString(p.DST)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode profile dst: %v", err)
+	}
+
+	loaded := NewBls()
+	if err := loaded.SetDST(dst); err != nil {
+		return nil, err
+	}
+	if (p.Hash == profileHashXMDSHA256) != (p.MapToCurve == profileMapSVDW) {
+		return nil, fmt.Errorf("profile hash %q does not match map to curve %q", p.Hash, p.MapToCurve)
+	}
+	switch p.MapToCurve {
+	case profileMapTryAndIncrement:
+		// Default Of NewBls.
+	case profileMapSVDWViaFr:
+		if err := loaded.MapViaScalarField(true); err != nil {
+			return nil, err
+		}
+	case profileMapSVDW:
+		if err := loaded.SetHashToCurve(HashToCurveSVDW); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported profile map to curve %q", p.MapToCurve)
+	}
+	loaded.SetPrivateKeySize(p.PrivateKeySize)
+	return loaded, nil
+}
+
+// Returns Keccak256 Of ExportProfile Without Private Key Size, So Only DST, Hash And Map To Curve Choice Are Covered,
+// Two Instances With Equal Fingerprints Hash Messages Identically.
+func (bls *BLS) ConfigFingerprint() ([32]byte, error) {
+	exported, err := json.Marshal(bls.hashingProfile())
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return keccak256(exported), nil
+}
@@ -0,0 +1,59 @@
+package bn128_bls
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestProfileRoundTrip(t *testing.T) {
+	signerBls := NewBls()
+	signerBls.SetDST([]byte("PROFILE_TEST_DST_"))
+	signerBls.MapViaScalarField(true)
+	signerBls.SetPrivateKeySize(128)
+
+	exported, err := signerBls.ExportProfile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifierBls, err := LoadProfile(exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reexported, _ := verifierBls.ExportProfile()
+	if !bytes.Equal(exported, reexported) {
+		t.Fatalf("expected identical profiles:\n%s\n%s", exported, reexported)
+	}
+
+	keyPair, _ := signerBls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := signerBls.SignBytes(keyPair, tempMessage)
+	ok, err := verifierBls.VerifyBytes(signature, keyPair.PubKey, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected signature to verify under loaded profile, ok: %v, err: %v", ok, err)
+	}
+
+	for _, invalid := range []string{
+		`{"version":2,"dst":"00","hash":"keccak256","mapToCurve":"try-and-increment","byteOrder":"big-endian-eip197","privateKeySize":256}`,
+		`{"version":1,"dst":"00","hash":"sha256","mapToCurve":"try-and-increment","byteOrder":"big-endian-eip197","privateKeySize":256}`,
+		`{"version":1,"dst":"","hash":"keccak256","mapToCurve":"try-and-increment","byteOrder":"big-endian-eip197","privateKeySize":256}`,
+		`{"version":1,"dst":"00","hash":"keccak256","mapToCurve":"svdw","byteOrder":"big-endian-eip197","privateKeySize":256}`,
+		`{"version":1,"dst":"00","hash":"xmd-sha256","mapToCurve":"try-and-increment","byteOrder":"big-endian-eip197","privateKeySize":256}`,
+		`not json`,
+	} {
+		if _, err := LoadProfile([]byte(invalid)); err == nil {
+			t.Fatalf("expected error for profile %s", invalid)
+		}
+	}
+}
+
+func TestProfileHashToCurve(t *testing.T) {
+	svdw := NewBls()
+	svdw.SetHashToCurve(HashToCurveSVDW)
+	exported, _ := svdw.ExportProfile()
+	loaded, err := LoadProfile(exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.hashToCurve != HashToCurveSVDW {
+		t.Fatalf("expected svdw after loading %s", exported)
+	}
+}
@@ -0,0 +1,86 @@
+package bn128_bls
+
+// Verification Receipts: Verifier Signs Outcome Of Each Verification With Its Own Key, Building Chain Of Custody.
+// Signed Claim Is Valid (1 Byte) || SignatureDigest || PubKeyID || MessageHash || DSTFingerprint || uint64 Unix Seconds
+// Of VerifiedAt, Where Digests Of Rejected Points Are Zero As In VerifyResult. Claim Is Hashed Under Separate Receipt DST
+// "BN128_BLS_RECEIPT_" || DST, So Verifier Key Which Also Signs Ordinary Messages Cannot Be Tricked Into Signing Receipt.
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+const receiptDSTPrefix = "BN128_BLS_RECEIPT_"
+
+type Receipt struct {
+	VerifyResult
+	// SignatureDigest Of Verified Signature, Zero If Signature Was Rejected By Validation.
+	SignatureDigest [32]byte
+	VerifierPubKey  [3][2]*big.Int
+	// Verifier Signature Over Claim Described In File Comment.
+	Signature [3]*big.Int
+}
+
+// Issues Receipts Signed By Verifier `keyPair`.
+type ReceiptIssuer struct {
+	bls     *BLS
+	keyPair *KeyPair
+}
+
+func (bls *BLS) NewReceiptIssuer(keyPair *KeyPair) (*ReceiptIssuer, error) {
+	if keyPair.IsDestroyed() {
+		return nil, ErrKeyDestroyed
+	}
+	return &ReceiptIssuer{bls: bls, keyPair: keyPair}, nil
+}
+
+// Verifies Like VerifyAudit And Returns Signed Receipt Of Outcome, Rejected Inputs Are Receipted As Invalid
+// And Validation Error Is Returned Alongside, Same As VerifyAudit.
+func (issuer *ReceiptIssuer) VerifyReceipt(signature [3]*big.Int, signerPubKey [3][2]*big.Int, message []byte) (Receipt, error) {
+	bls := issuer.bls
+	result, verifyErr := bls.VerifyAudit(signature, signerPubKey, message)
+	receipt := Receipt{VerifyResult: result, VerifierPubKey: CloneG2(issuer.keyPair.PubKey)}
+	if bls.ValidateSignature(signature) == nil {
+		receipt.SignatureDigest = bls.SignatureDigest(signature)
+	}
+	receiptSignature, err := bls.signForPurpose(issuer.keyPair, receiptDSTPrefix, receiptClaim(receipt))
+	if err != nil {
+		return Receipt{}, fmt.Errorf("failed to sign receipt: %w", err)
+	}
+	receipt.Signature = receiptSignature
+	return receipt, verifyErr
+}
+
+// Checks `receipt` Is Signed By receipt.VerifierPubKey And Describes Verification Of `signature` By `signerPubKey` Over `message`.
+func (bls *BLS) CheckReceipt(receipt Receipt, signature [3]*big.Int, signerPubKey [3][2]*big.Int, message []byte) (bool, error) {
+	ok, err := bls.verifyForPurpose(receipt.Signature, receipt.VerifierPubKey, receiptDSTPrefix, receiptClaim(receipt))
+	if err != nil || !ok {
+		return false, err
+	}
+	if receipt.MessageHash != keccak256(message) || receipt.DSTFingerprint != bls.DSTFingerprint() {
+		return false, nil
+	}
+	var pubKeyID, signatureDigest [32]byte
+	if bls.ValidatePubKey(signerPubKey) == nil {
+		pubKeyID = bls.PubKeyIdentifier(signerPubKey)
+	}
+	if bls.ValidateSignature(signature) == nil {
+		signatureDigest = bls.SignatureDigest(signature)
+	}
+	return receipt.PubKeyID == pubKeyID && receipt.SignatureDigest == signatureDigest, nil
+}
+
+func receiptClaim(receipt Receipt) []byte {
+	claim := make([]byte, 0, 1+4*32+8)
+	if receipt.Valid {
+		claim = append(claim, 1)
+	} else {
+		claim = append(claim, 0)
+	}
+	claim = append(claim, receipt.SignatureDigest[:]...)
+	claim = append(claim, receipt.PubKeyID[:]...)
+	claim = append(claim, receipt.MessageHash[:]...)
+	claim = append(claim, receipt.DSTFingerprint[:]...)
+	return binary.BigEndian.AppendUint64(claim, uint64(receipt.VerifiedAt.Unix()))
+}
@@ -0,0 +1,53 @@
+package bn128_bls
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVerifyReceipt(t *testing.T) {
+	keyPairs, _ := tempCommittee(t)
+	signer, verifier := keyPairs[0], keyPairs[1]
+	signature, _ := bls.SignBytes(signer, tempMessage)
+
+	issuer, err := bls.NewReceiptIssuer(verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt, err := issuer.VerifyReceipt(signature, signer.PubKey, tempMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !receipt.Valid || !bls.bn128.G2.Equal(receipt.VerifierPubKey, verifier.PubKey) {
+		t.Fatal("expected valid receipt issued by verifier")
+	}
+	ok, err := bls.CheckReceipt(receipt, signature, signer.PubKey, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected receipt to check, ok: %v, err: %v", ok, err)
+	}
+
+	// Receipt Is Bound To Original Facts.
+	if ok, _ := bls.CheckReceipt(receipt, signature, signer.PubKey, tempMessages[0]); ok {
+		t.Fatal("expected receipt to fail for other message")
+	}
+	if ok, _ := bls.CheckReceipt(receipt, signature, keyPairs[2].PubKey, tempMessage); ok {
+		t.Fatal("expected receipt to fail for other signer")
+	}
+	backdated := receipt
+	backdated.VerifiedAt = receipt.VerifiedAt.Add(-time.Hour)
+	if ok, _ := bls.CheckReceipt(backdated, signature, signer.PubKey, tempMessage); ok {
+		t.Fatal("expected backdated receipt to fail")
+	}
+	flipped := receipt
+	flipped.Valid = false
+	if ok, _ := bls.CheckReceipt(flipped, signature, signer.PubKey, tempMessage); ok {
+		t.Fatal("expected receipt with flipped outcome to fail")
+	}
+
+	// Verifier Signing Ordinary Messages Must Not Be Usable To Forge Receipts.
+	forged := flipped
+	forged.Signature, _ = bls.SignBytes(verifier, append([]byte("BN128_BLS_RECEIPT_"), receiptClaim(flipped)...))
+	if ok, _ := bls.CheckReceipt(forged, signature, signer.PubKey, tempMessage); ok {
+		t.Fatal("expected receipt signed with SignBytes to fail")
+	}
+}
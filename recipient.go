@@ -0,0 +1,39 @@
+package bn128_bls
+
+// Directed Signatures Bound To Intended Recipient. Signed Message Is PubKeyToBytes(recipient) || message, Hashed Under
+// Separate Recipient DST "BN128_BLS_RECIPIENT_" || DST. Recipient Encoding Has Fixed Size And Is Affine,
+// So Every Jacobian Representation Of Same Recipient Yields Same Signature.
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const recipientDSTPrefix = "BN128_BLS_RECIPIENT_"
+
+// Signs `message` Addressed To `recipientPubKeyG2`, Signature Does Not Verify For Any Other Recipient.
+func (bls *BLS) SignForRecipient(keyPair *KeyPair, message []byte, recipientPubKeyG2 [3][2]*big.Int) ([3]*big.Int, error) {
+	directed, err := bls.recipientMessage(message, recipientPubKeyG2)
+	if err != nil {
+		return [3]*big.Int{}, err
+	}
+	return bls.signForPurpose(keyPair, recipientDSTPrefix, directed)
+}
+
+// Verifies Signature Produced By SignForRecipient For Same `message` And `recipientPubKeyG2`.
+func (bls *BLS) VerifyForRecipient(signature [3]*big.Int, signerPubKey [3][2]*big.Int, message []byte, recipientPubKeyG2 [3][2]*big.Int) (bool, error) {
+	directed, err := bls.recipientMessage(message, recipientPubKeyG2)
+	if err != nil {
+		return false, err
+	}
+	return bls.verifyForPurpose(signature, signerPubKey, recipientDSTPrefix, directed)
+}
+
+func (bls *BLS) recipientMessage(message []byte, recipientPubKeyG2 [3][2]*big.Int) ([]byte, error) {
+	if err := bls.ValidatePubKey(recipientPubKeyG2); err != nil {
+		return nil, fmt.Errorf("invalid recipient pubKey: %w", err)
+	}
+	directed := make([]byte, 0, PubKeySize+len(message))
+	directed = append(directed, bls.PubKeyToBytes(recipientPubKeyG2)...)
+	return append(directed, message...), nil
+}
@@ -0,0 +1,54 @@
+package bn128_bls
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestSignForRecipient(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	signer, recipientA, recipientB := keyPairs[0], pubKeys[1], pubKeys[2]
+
+	signature, err := bls.SignForRecipient(signer, tempMessage, recipientA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := bls.VerifyForRecipient(signature, signer.PubKey, tempMessage, recipientA)
+	if err != nil || !ok {
+		t.Fatalf("expected signature to verify for recipient A, ok: %v, err: %v", ok, err)
+	}
+	ok, err = bls.VerifyForRecipient(signature, signer.PubKey, tempMessage, recipientB)
+	if err != nil || ok {
+		t.Fatalf("expected signature for recipient A to fail for recipient B, ok: %v, err: %v", ok, err)
+	}
+
+	// Rescaled Jacobian Representation Of Recipient Gives Same Signature.
+	fq2 := bls.bn128.Fq2
+	lambda := fq2.Add(fq2.One(), fq2.One())
+	rescaled := [3][2]*big.Int{
+		fq2.Mul(recipientA[0], fq2.Square(lambda)),
+		fq2.Mul(recipientA[1], fq2.Mul(fq2.Square(lambda), lambda)),
+		fq2.Mul(recipientA[2], lambda),
+	}
+	if !bls.bn128.G2.Equal(rescaled, recipientA) {
+		t.Fatal("rescaled recipient is not same point")
+	}
+	same, _ := bls.SignForRecipient(signer, tempMessage, rescaled)
+	if !bls.bn128.G1.Equal(signature, same) {
+		t.Fatal("expected recipient canonicalization to give same signature")
+	}
+
+	if _, err := bls.SignForRecipient(signer, tempMessage, randomTwistPoint(t)); err == nil {
+		t.Fatal("expected error for off subgroup recipient")
+	}
+
+	// SignBytes Over Attacker-Chosen Bytes Must Not Produce Recipient-Bound Signature.
+	chosen := append(append([]byte("BN128_BLS_RECIPIENT_"), bls.PubKeyToBytes(recipientA)...), tempMessage...)
+	plain, _ := bls.SignBytes(signer, chosen)
+	if ok, _ := bls.VerifyForRecipient(plain, signer.PubKey, tempMessage, recipientA); ok {
+		t.Fatal("expected plain signature not to verify as recipient-bound signature")
+	}
+	if ok, _ := bls.VerifyBytes(signature, signer.PubKey, append(bls.PubKeyToBytes(recipientA), tempMessage...)); ok {
+		t.Fatal("expected recipient-bound signature not to verify as plain signature")
+	}
+}
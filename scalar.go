@@ -0,0 +1,47 @@
+package bn128_bls
+
+// Uniform Sampling Of Scalars In [1, R-1], Shared By Everything In This Package That Needs Fresh Randomness.
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+)
+
+const (
+	// 48 Bytes Reduced Modulo 254 Bit R Leaves Bias Below 2^-128.
+	randomScalarBytes    = 48
+	randomScalarAttempts = 16
+)
+
+// Order Of G1 And G2 Subgroups (Scalar Field Modulus).
+var curveOrder, _ = new(big.Int).SetString("21888242871839275222246405745257275088548364400416034343698204186575808495617", 10)
+
+// Returns Copy Of Order R Of G1 And G2 Subgroups.
+func Order() *big.Int {
+	return new(big.Int).Set(curveOrder)
+}
+
+// Reports Whether `scalar` Is Canonical Private Key, That Is 1 <= scalar < R.
+func IsValidPrivateKey(scalar *big.Int) bool {
+	return scalar != nil && scalar.Sign() > 0 && scalar.Cmp(curveOrder) < 0
+}
+
+// Returns Uniformly Random Scalar In [1, R-1] Read From `r`, Pass crypto/rand.Reader Unless Deterministic Output Is Needed.
+func RandomScalar(r io.Reader) (*big.Int, error) {
+	return randomScalar(r)
+}
+
+func randomScalar(r io.Reader) (*big.Int, error) {
+	buf := make([]byte, randomScalarBytes)
+	for i := 0; i < randomScalarAttempts; i++ {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, fmt.Errorf("failed to read random bytes: %v", err)
+		}
+		scalar := new(big.Int).Mod(new(big.Int).SetBytes(buf), curveOrder)
+		if scalar.Sign() != 0 {
+			return scalar, nil
+		}
+	}
+	return nil, fmt.Errorf("random source returned zero scalar %d times", randomScalarAttempts)
+}
@@ -0,0 +1,76 @@
+package bn128_bls
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"testing"
+)
+
+func TestRandomScalarBias(t *testing.T) {
+	const (
+		samples = 16000
+		buckets = 16
+	)
+	if curveOrder.Cmp(bls.bn128.R) != 0 {
+		t.Fatal("curveOrder does not match bn128 R")
+	}
+	counts := make([]int, buckets)
+	for i := 0; i < samples; i++ {
+		scalar, err := RandomScalar(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if scalar.Sign() <= 0 || scalar.Cmp(curveOrder) >= 0 {
+			t.Fatalf("scalar out of range: %s", scalar)
+		}
+		bucket := new(big.Int).Div(new(big.Int).Mul(scalar, big.NewInt(buckets)), curveOrder)
+		counts[bucket.Int64()]++
+	}
+
+	// Chi-Square With 15 Degrees Of Freedom, 99.99th Percentile Is About 44.3.
+	expected := float64(samples) / buckets
+	chiSquare := 0.0
+	for _, count := range counts {
+		diff := float64(count) - expected
+		chiSquare += diff * diff / expected
+	}
+	if chiSquare > 44.3 {
+		t.Fatalf("random scalars look biased, chi-square: %f, counts: %v", chiSquare, counts)
+	}
+}
+
+func TestRandomScalarRejectsZero(t *testing.T) {
+	zero := make([]byte, randomScalarBytes)
+	one := append(make([]byte, randomScalarBytes-1), 1)
+	scalar, err := RandomScalar(bytes.NewReader(append(zero, one...)))
+	if err != nil || scalar.Cmp(big.NewInt(1)) != 0 {
+		t.Fatalf("expected zero draw to be rejected and next draw returned, got: %v, err: %v", scalar, err)
+	}
+
+	if _, err := RandomScalar(bytes.NewReader(make([]byte, randomScalarBytes*randomScalarAttempts))); err == nil {
+		t.Fatal("expected error for random source returning only zeros")
+	}
+}
+
+func TestIsValidPrivateKey(t *testing.T) {
+	r := Order()
+	cases := map[string]struct {
+		scalar *big.Int
+		valid  bool
+	}{
+		"nil":       {nil, false},
+		"negative":  {big.NewInt(-1), false},
+		"zero":      {big.NewInt(0), false},
+		"one":       {big.NewInt(1), true},
+		"mid range": {new(big.Int).Rsh(r, 1), true},
+		"r-1":       {new(big.Int).Sub(r, big.NewInt(1)), true},
+		"r":         {r, false},
+		"r+1":       {new(big.Int).Add(r, big.NewInt(1)), false},
+	}
+	for name, c := range cases {
+		if IsValidPrivateKey(c.scalar) != c.valid {
+			t.Fatalf("%s: expected %v", name, c.valid)
+		}
+	}
+}
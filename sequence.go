@@ -0,0 +1,61 @@
+package bn128_bls
+
+// Sequenced Signatures For Replay Protection, Sequence Number Is Bound Into Signed Message As uint64 seq || message,
+// Hashed Under Separate Sequence DST "BN128_BLS_SEQUENCE_" || DST. SequenceTracker Then Enforces Strictly Increasing seq Per Signer.
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+const sequenceDSTPrefix = "BN128_BLS_SEQUENCE_"
+
+var ErrSequenceNotIncreasing = errors.New("sequence number is not greater than last accepted one")
+
+// Signs `message` Together With Sequence Number `seq`.
+func (bls *BLS) SignSequenced(keyPair *KeyPair, message []byte, seq uint64) ([3]*big.Int, error) {
+	return bls.signForPurpose(keyPair, sequenceDSTPrefix, sequenceMessage(message, seq))
+}
+
+// Verifies Signature Produced By SignSequenced, Without Any Replay Check, See SequenceTracker For That.
+func (bls *BLS) VerifySequenced(signature [3]*big.Int, signerPubKey [3][2]*big.Int, message []byte, seq uint64) (bool, error) {
+	return bls.verifyForPurpose(signature, signerPubKey, sequenceDSTPrefix, sequenceMessage(message, seq))
+}
+
+// Tracks Last Accepted Sequence Number Of Every Signer, Keyed By PubKeyIdentifier. Gaps Are Allowed,
+// Reused Or Lower Numbers Are Rejected. Safe For Concurrent Use.
+type SequenceTracker struct {
+	bls  *BLS
+	mu   sync.Mutex
+	last map[[32]byte]uint64
+}
+
+func (bls *BLS) NewSequenceTracker() *SequenceTracker {
+	return &SequenceTracker{bls: bls, last: map[[32]byte]uint64{}}
+}
+
+// Verifies Sequenced Signature And Records `seq` As Last Accepted For Signer, Returning ErrSequenceNotIncreasing
+// If It Is Not Greater Than Previously Accepted One. Invalid Signatures Never Advance Tracker.
+func (tracker *SequenceTracker) Accept(signature [3]*big.Int, signerPubKey [3][2]*big.Int, message []byte, seq uint64) (bool, error) {
+	ok, err := tracker.bls.VerifySequenced(signature, signerPubKey, message, seq)
+	if err != nil || !ok {
+		return false, err
+	}
+	signerID := tracker.bls.PubKeyIdentifier(signerPubKey)
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	if last, seen := tracker.last[signerID]; seen && seq <= last {
+		return false, fmt.Errorf("%w: got %d, last is %d", ErrSequenceNotIncreasing, seq, last)
+	}
+	tracker.last[signerID] = seq
+	return true, nil
+}
+
+func sequenceMessage(message []byte, seq uint64) []byte {
+	data := make([]byte, 0, 8+len(message))
+	data = binary.BigEndian.AppendUint64(data, seq)
+	return append(data, message...)
+}
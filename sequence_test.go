@@ -0,0 +1,65 @@
+package bn128_bls
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+func TestSequenceTracker(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signatures := map[uint64][3]*big.Int{}
+	for _, seq := range []uint64{1, 2, 5} {
+		signatures[seq], _ = bls.SignSequenced(keyPair, tempMessage, seq)
+	}
+	if ok, _ := bls.VerifySequenced(signatures[1], keyPair.PubKey, tempMessage, 2); ok {
+		t.Fatal("expected signature to fail under other sequence number")
+	}
+	plain, _ := bls.SignBytes(keyPair, append([]byte("BN128_BLS_SEQUENCE_"), sequenceMessage(tempMessage, 9)...))
+	if ok, _ := bls.VerifySequenced(plain, keyPair.PubKey, tempMessage, 9); ok {
+		t.Fatal("expected plain signature over prefixed message not to verify as sequenced signature")
+	}
+
+	tracker := bls.NewSequenceTracker()
+	for _, seq := range []uint64{1, 2, 5} {
+		if ok, err := tracker.Accept(signatures[seq], keyPair.PubKey, tempMessage, seq); err != nil || !ok {
+			t.Fatalf("seq %d: expected in-order signature to be accepted, ok: %v, err: %v", seq, ok, err)
+		}
+	}
+	if _, err := tracker.Accept(signatures[5], keyPair.PubKey, tempMessage, 5); !errors.Is(err, ErrSequenceNotIncreasing) {
+		t.Fatalf("expected replay to be rejected, got %v", err)
+	}
+	if _, err := tracker.Accept(signatures[2], keyPair.PubKey, tempMessage, 2); !errors.Is(err, ErrSequenceNotIncreasing) {
+		t.Fatalf("expected out-of-order sequence to be rejected, got %v", err)
+	}
+}
+
+// Run With -race: Only One Of Concurrent Submissions Of Same Sequence Number Wins.
+func TestSequenceTrackerConcurrent(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignSequenced(keyPair, tempMessage, 7)
+	tracker := bls.NewSequenceTracker()
+
+	var wg sync.WaitGroup
+	accepted := make(chan bool, 4)
+	for i := 0; i < cap(accepted); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ok, _ := tracker.Accept(signature, keyPair.PubKey, tempMessage, 7)
+			accepted <- ok
+		}()
+	}
+	wg.Wait()
+	close(accepted)
+	count := 0
+	for ok := range accepted {
+		if ok {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Fatalf("expected exactly one acceptance, got %d", count)
+	}
+}
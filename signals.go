@@ -0,0 +1,47 @@
+package bn128_bls
+
+// Signatures Over SNARK Public Signals. Canonical Encoding Of Signal Vector Is
+// uint32 Count || (signal mod Q As 32 Byte Big-Endian) For Every Signal,
+// Which Is Then Signed Under Separate Public Signals DST "BN128_BLS_PUBLIC_SIGNALS_" || DST.
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+const publicSignalsDSTPrefix = "BN128_BLS_PUBLIC_SIGNALS_"
+
+// Signs Canonical Encoding Of `signals`, Signals Congruent Modulo Q Produce Same Signature.
+func (bls *BLS) SignPublicSignals(keyPair *KeyPair, signals []*big.Int) ([3]*big.Int, error) {
+	message, err := bls.publicSignalsMessage(signals)
+	if err != nil {
+		return [3]*big.Int{}, err
+	}
+	return bls.signForPurpose(keyPair, publicSignalsDSTPrefix, message)
+}
+
+// Verifies Signature Produced By SignPublicSignals.
+func (bls *BLS) VerifyPublicSignals(signature [3]*big.Int, signerPubKey [3][2]*big.Int, signals []*big.Int) (bool, error) {
+	message, err := bls.publicSignalsMessage(signals)
+	if err != nil {
+		return false, err
+	}
+	return bls.verifyForPurpose(signature, signerPubKey, publicSignalsDSTPrefix, message)
+}
+
+func (bls *BLS) publicSignalsMessage(signals []*big.Int) ([]byte, error) {
+	if uint64(len(signals)) > 0xffffffff {
+		return nil, fmt.Errorf("too many signals, got %d", len(signals))
+	}
+	message := make([]byte, 0, 4+len(signals)*coordinateSize)
+	message = binary.BigEndian.AppendUint32(message, uint32(len(signals)))
+	for i, signal := range signals {
+		if signal == nil {
+			return nil, fmt.Errorf("signal at index %d is nil", i)
+		}
+		reduced := ToFixed32(new(big.Int).Mod(signal, bls.bn128.Q))
+		message = append(message, reduced[:]...)
+	}
+	return message, nil
+}
@@ -0,0 +1,41 @@
+package bn128_bls
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestSignPublicSignals(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signals := []*big.Int{big.NewInt(0), big.NewInt(42), new(big.Int).Sub(bls.bn128.Q, big.NewInt(1))}
+	signature, err := bls.SignPublicSignals(keyPair, signals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := bls.VerifyPublicSignals(signature, keyPair.PubKey, signals)
+	if err != nil || !ok {
+		t.Fatalf("expected signals to verify, ok: %v, err: %v", ok, err)
+	}
+
+	// Unreduced Signal Has Same Canonical Encoding.
+	unreduced := []*big.Int{new(big.Int).Set(bls.bn128.Q), big.NewInt(42), big.NewInt(-1)}
+	same, _ := bls.SignPublicSignals(keyPair, unreduced)
+	if !bls.bn128.G1.Equal(signature, same) {
+		t.Fatal("expected signals congruent modulo q to produce same signature")
+	}
+
+	changed := []*big.Int{big.NewInt(0), big.NewInt(43), signals[2]}
+	ok, err = bls.VerifyPublicSignals(signature, keyPair.PubKey, changed)
+	if err != nil || ok {
+		t.Fatalf("expected changed signals to fail, ok: %v, err: %v", ok, err)
+	}
+	if _, err := bls.SignPublicSignals(keyPair, []*big.Int{nil}); err == nil {
+		t.Fatal("expected error for nil signal")
+	}
+
+	encoded, _ := bls.publicSignalsMessage(signals)
+	plain, _ := bls.SignBytes(keyPair, append([]byte("BN128_BLS_PUBLIC_SIGNALS_"), encoded...))
+	if ok, _ := bls.VerifyPublicSignals(plain, keyPair.PubKey, signals); ok {
+		t.Fatal("expected plain signature over prefixed encoding not to verify as signals signature")
+	}
+}
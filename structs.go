@@ -0,0 +1,47 @@
+package bn128_bls
+
+// Signatures Over Go Values. Value Is Encoded As Canonical JSON: json.Marshal, Then Decoded Into Generic Form
+// (Numbers Kept Verbatim) And Marshaled Again, So Object Keys Are Sorted Regardless Of Struct Field Or Map Order.
+// Floats Use Shortest Round-Trip Form Of encoding/json, NaN And Infinity Are Rejected.
+// Canonical JSON Is Signed Under Separate Struct DST "BN128_BLS_STRUCT_" || DST.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+const structDSTPrefix = "BN128_BLS_STRUCT_"
+
+// Signs Canonical JSON Encoding Of `v`.
+func (bls *BLS) SignStruct(keyPair *KeyPair, v any) ([3]*big.Int, error) {
+	message, err := canonicalJSON(v)
+	if err != nil {
+		return [3]*big.Int{}, err
+	}
+	return bls.signForPurpose(keyPair, structDSTPrefix, message)
+}
+
+// Verifies Signature Produced By SignStruct.
+func (bls *BLS) VerifyStruct(signature [3]*big.Int, signerPubKey [3][2]*big.Int, v any) (bool, error) {
+	message, err := canonicalJSON(v)
+	if err != nil {
+		return false, err
+	}
+	return bls.verifyForPurpose(signature, signerPubKey, structDSTPrefix, message)
+}
+
+func canonicalJSON(v any) ([]byte, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode value: %v", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var generic any
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, fmt.Errorf("failed to canonicalize value: %v", err)
+	}
+	return json.Marshal(generic)
+}
@@ -0,0 +1,43 @@
+package bn128_bls
+
+import (
+	"bytes"
+	"testing"
+)
+
+type tempTransfer struct {
+	To     string            `json:"to"`
+	Amount float64           `json:"amount"`
+	Memo   map[string]string `json:"memo"`
+}
+
+func TestSignStruct(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	transfer := tempTransfer{To: "bob", Amount: 1.5, Memo: map[string]string{"z": "last", "a": "first"}}
+	signature, err := bls.SignStruct(keyPair, transfer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Same Data With Different Field And Key Order Has Same Canonical Encoding.
+	reordered := map[string]any{"memo": map[string]string{"a": "first", "z": "last"}, "amount": 1.5, "to": "bob"}
+	encoded, _ := canonicalJSON(transfer)
+	reorderedEncoded, _ := canonicalJSON(reordered)
+	if !bytes.Equal(encoded, reorderedEncoded) || string(encoded) != `{"amount":1.5,"memo":{"a":"first","z":"last"},"to":"bob"}` {
+		t.Fatalf("unexpected canonical encoding %s, %s", encoded, reorderedEncoded)
+	}
+
+	ok, err := bls.VerifyStruct(signature, keyPair.PubKey, transfer)
+	if err != nil || !ok {
+		t.Fatalf("expected struct to verify, ok: %v, err: %v", ok, err)
+	}
+	plain, _ := bls.SignBytes(keyPair, append([]byte("BN128_BLS_STRUCT_"), encoded...))
+	if ok, _ := bls.VerifyStruct(plain, keyPair.PubKey, transfer); ok {
+		t.Fatal("expected plain signature over prefixed encoding not to verify as struct signature")
+	}
+	transfer.Amount = 15
+	ok, err = bls.VerifyStruct(signature, keyPair.PubKey, transfer)
+	if err != nil || ok {
+		t.Fatalf("expected mutated struct to fail, ok: %v, err: %v", ok, err)
+	}
+}
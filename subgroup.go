@@ -0,0 +1,263 @@
+package bn128_bls
+
+// G2 Subgroup Membership Checks, G1 Has Cofactor 1 So Every Point On G1 Curve Is Already In Order-R Subgroup.
+// Two Equivalent Strategies Are Available:
+//   - r-multiplication: [R]P == O
+//   - frobenius: ψ(P) == [6x^2]P, Where ψ Is Untwist-Frobenius-Twist Endomorphism And x Is BN Parameter.
+//     On G2 ψ Acts As Multiplication By Q ≡ 6x^2 (mod R), And For BN Curves Only G2 Points Satisfy It.
+// Faster One Is Selected Once Per Process By Small Benchmark, See SubgroupCheckStrategy.
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+)
+
+var (
+	ErrNilCoordinate          = errors.New("point has nil coordinate")
+	ErrNonCanonicalCoordinate = errors.New("point coordinate is not reduced modulo q")
+	ErrNotOnCurve             = errors.New("point is not on curve")
+	ErrNotInSubgroup          = errors.New("point is not in order-r subgroup")
+	ErrPointAtInfinity        = errors.New("point is at infinity")
+)
+
+// Error Returned By Checked Parse Methods, `Err` Is One Of Errors Above So It Can Be Matched Using errors.Is.
+type PointError struct {
+	Point string
+	Err   error
+}
+
+func (e *PointError) Error() string {
+	return fmt.Sprintf("invalid %s: %v", e.Point, e.Err)
+}
+
+func (e *PointError) Unwrap() error {
+	return e.Err
+}
+
+const (
+	SubgroupCheckRMultiplication = "r-multiplication"
+	SubgroupCheckFrobenius       = "frobenius"
+)
+
+var (
+	// 6x^2 Where x = 4965661367192848881 Is BN254 Curve Parameter.
+	sixXSquared, _ = new(big.Int).SetString("147946756881789318990833708069417712966", 10)
+
+	// G2 Cofactor h = #E'(Fq2) / R = 2Q - R, G1 Cofactor Is 1.
+	cofactorG2, _ = new(big.Int).SetString("21888242871839275222246405745257275088844257914179612981679871602714643921549", 10)
+
+	subgroupCheckOnce     sync.Once
+	subgroupCheckSelected string
+)
+
+// Returns Name Of Subgroup Check Strategy Selected For This Process, Running Selection Benchmark On First Call.
+func (bls *BLS) SubgroupCheckStrategy() string {
+	subgroupCheckOnce.Do(func() {
+		subgroupCheckSelected = bls.benchmarkSubgroupChecks()
+	})
+	return subgroupCheckSelected
+}
+
+// Checks Whether Jacobian `point` Satisfies y^2 = x^3 + 3, Point At Infinity Is Considered On Curve.
+func (bls *BLS) IsOnCurveG1(point [3]*big.Int) bool {
+	if point[0] == nil || point[1] == nil || point[2] == nil {
+		return false
+	}
+	fq := bls.bn128.Fq1
+	if fq.IsZero(fq.Affine(point[2])) {
+		return true
+	}
+	z2 := fq.Square(point[2])
+	z6 := fq.Mul(fq.Square(z2), z2)
+	lhs := fq.Square(point[1])
+	rhs := fq.Add(fq.Mul(fq.Square(point[0]), point[0]), fq.Mul(bls.bn128.CoefB, z6))
+	return fq.Equal(lhs, rhs)
+}
+
+// Checks Whether Jacobian `point` Satisfies y^2 = x^3 + 3/(9+u), Point At Infinity Is Considered On Curve.
+func (bls *BLS) IsOnCurveG2(point [3][2]*big.Int) bool {
+	for _, coordinate := range point {
+		if coordinate[0] == nil || coordinate[1] == nil {
+			return false
+		}
+	}
+	fq2 := bls.bn128.Fq2
+	if fq2.IsZero(fq2.Affine(point[2])) {
+		return true
+	}
+	z2 := fq2.Square(point[2])
+	z6 := fq2.Mul(fq2.Square(z2), z2)
+	lhs := fq2.Square(point[1])
+	rhs := fq2.Add(fq2.Mul(fq2.Square(point[0]), point[0]), fq2.Mul(bls.bn128.TwistCoefB, z6))
+	return fq2.Equal(lhs, rhs)
+}
+
+func (bls *BLS) checkPointG1(name string, point [3]*big.Int) error {
+	for _, coordinate := range point {
+		if coordinate == nil {
+			return &PointError{Point: name, Err: ErrNilCoordinate}
+		}
+	}
+	if !bls.IsOnCurveG1(point) {
+		return &PointError{Point: name, Err: ErrNotOnCurve}
+	}
+	return nil
+}
+
+func (bls *BLS) checkPointG2(name string, point [3][2]*big.Int) error {
+	for _, coordinate := range point {
+		if coordinate[0] == nil || coordinate[1] == nil {
+			return &PointError{Point: name, Err: ErrNilCoordinate}
+		}
+	}
+	if !bls.IsOnCurveG2(point) {
+		return &PointError{Point: name, Err: ErrNotOnCurve}
+	}
+	return nil
+}
+
+// Returns Copy Of `point` With Every Coordinate Reduced Modulo Q, In Strict Mode Unreduced Coordinate Is Rejected Instead.
+func (bls *BLS) NormalizePointG1(point [3]*big.Int, strict bool) ([3]*big.Int, error) {
+	var normalized [3]*big.Int
+	for i, coordinate := range point {
+		reduced, err := bls.normalizeCoordinate(coordinate, strict)
+		if err != nil {
+			return [3]*big.Int{}, err
+		}
+		normalized[i] = reduced
+	}
+	return normalized, nil
+}
+
+// G2 Version Of NormalizePointG1.
+func (bls *BLS) NormalizePointG2(point [3][2]*big.Int, strict bool) ([3][2]*big.Int, error) {
+	var normalized [3][2]*big.Int
+	for i, coordinate := range point {
+		for j, c := range coordinate {
+			reduced, err := bls.normalizeCoordinate(c, strict)
+			if err != nil {
+				return [3][2]*big.Int{}, err
+			}
+			normalized[i][j] = reduced
+		}
+	}
+	return normalized, nil
+}
+
+func (bls *BLS) normalizeCoordinate(coordinate *big.Int, strict bool) (*big.Int, error) {
+	if coordinate == nil {
+		return nil, ErrNilCoordinate
+	}
+	if coordinate.Sign() >= 0 && coordinate.Cmp(bls.bn128.Q) < 0 {
+		return new(big.Int).Set(coordinate), nil
+	}
+	if strict {
+		return nil, ErrNonCanonicalCoordinate
+	}
+	return new(big.Int).Mod(coordinate, bls.bn128.Q), nil
+}
+
+// Checks Signature Has Reduced Coordinates, Is On Curve And Not At Infinity, Since G1 Cofactor Is 1 This Also Implies Subgroup Membership.
+func (bls *BLS) ValidateSignature(signature [3]*big.Int) error {
+	if _, err := bls.NormalizePointG1(signature, true); err != nil {
+		return err
+	}
+	if !bls.IsOnCurveG1(signature) {
+		return ErrNotOnCurve
+	}
+	if bls.bn128.G1.IsZero(signature) {
+		return ErrPointAtInfinity
+	}
+	return nil
+}
+
+// Checks PubKey Has Reduced Coordinates, Is On Curve, Not At Infinity And In Order-R Subgroup Of G2.
+func (bls *BLS) ValidatePubKey(pubKey [3][2]*big.Int) error {
+	return bls.validatePubKey(pubKey, true)
+}
+
+func (bls *BLS) validatePubKey(pubKey [3][2]*big.Int, checkSubgroup bool) error {
+	if _, err := bls.NormalizePointG2(pubKey, true); err != nil {
+		return err
+	}
+	if !bls.IsOnCurveG2(pubKey) {
+		return ErrNotOnCurve
+	}
+	if bls.bn128.G2.IsZero(pubKey) {
+		return ErrPointAtInfinity
+	}
+	if checkSubgroup && !bls.IsInSubgroupG2(pubKey) {
+		return ErrNotInSubgroup
+	}
+	return nil
+}
+
+// Returns Copy Of BN254 G2 Cofactor 21888242871839275222246405745257275088844257914179612981679871602714643921549 (2Q - R).
+// It Is Coprime To R, So Multiplying By It Never Maps Subgroup Point To Infinity.
+func CofactorG2() *big.Int {
+	return new(big.Int).Set(cofactorG2)
+}
+
+// Multiplies Twist Point `point` By G2 Cofactor, Mapping It Into Order-R Subgroup.
+// Package Has No HashToG2, This Is For Callers That Map Into Twist Themselves And Need Point ValidatePubKey Accepts.
+func (bls *BLS) ClearCofactorG2(point [3][2]*big.Int) [3][2]*big.Int {
+	return bls.bn128.G2.MulScalar(point, cofactorG2)
+}
+
+// Checks Whether `point` Is In Order-R Subgroup Of G2, `point` Is Assumed To Be On Curve.
+func (bls *BLS) IsInSubgroupG2(point [3][2]*big.Int) bool {
+	if bls.subgroupCheckHook != nil {
+		bls.subgroupCheckHook(point)
+	}
+	if bls.SubgroupCheckStrategy() == SubgroupCheckFrobenius {
+		return bls.isInSubgroupG2Frobenius(point)
+	}
+	return bls.isInSubgroupG2RMultiplication(point)
+}
+
+func (bls *BLS) isInSubgroupG2RMultiplication(point [3][2]*big.Int) bool {
+	return bls.bn128.G2.IsZero(bls.bn128.G2.MulScalar(point, bls.bn128.R))
+}
+
+func (bls *BLS) isInSubgroupG2Frobenius(point [3][2]*big.Int) bool {
+	return bls.bn128.G2.Equal(bls.psiG2(point), bls.bn128.G2.MulScalar(point, sixXSquared))
+}
+
+// Untwist-Frobenius-Twist Endomorphism, Works Directly On Jacobian Coordinates Since Frobenius Is Field Automorphism.
+// Used By Frobenius Subgroup Check And By Last Two Line Evaluations Of Optimal Ate Pairing In precomputeG2Lines.
+func (bls *BLS) psiG2(point [3][2]*big.Int) [3][2]*big.Int {
+	fq2 := bls.bn128.Fq2
+	return [3][2]*big.Int{
+		fq2.Mul(bls.conjugateFq2(point[0]), bls.bn128.TwistMulByQX),
+		fq2.Mul(bls.conjugateFq2(point[1]), bls.bn128.TwistMulByQY),
+		bls.conjugateFq2(point[2]),
+	}
+}
+
+func (bls *BLS) conjugateFq2(a [2]*big.Int) [2]*big.Int {
+	return [2]*big.Int{a[0], bls.bn128.Fq1.Neg(a[1])}
+}
+
+// Times Both Strategies On G2 Generator, Whole Run Takes Few Scalar Multiplications.
+func (bls *BLS) benchmarkSubgroupChecks() string {
+	const rounds = 2
+	start := time.Now()
+	for i := 0; i < rounds; i++ {
+		bls.isInSubgroupG2RMultiplication(bls.bn128.G2.G)
+	}
+	rMultiplicationTime := time.Since(start)
+
+	start = time.Now()
+	for i := 0; i < rounds; i++ {
+		bls.isInSubgroupG2Frobenius(bls.bn128.G2.G)
+	}
+	frobeniusTime := time.Since(start)
+
+	if frobeniusTime < rMultiplicationTime {
+		return SubgroupCheckFrobenius
+	}
+	return SubgroupCheckRMultiplication
+}
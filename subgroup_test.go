@@ -0,0 +1,142 @@
+package bn128_bls
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// Returns Random Point On Twist Curve, Which Is Almost Surely Outside Order-R Subgroup.
+func randomTwistPoint(t *testing.T) [3][2]*big.Int {
+	fq1 := bls.bn128.Fq1
+	fq2 := bls.bn128.Fq2
+	for {
+		x0, err := fq1.Rand()
+		if err != nil {
+			t.Fatal(err)
+		}
+		x1, err := fq1.Rand()
+		if err != nil {
+			t.Fatal(err)
+		}
+		x := [2]*big.Int{x0, x1}
+		y2 := fq2.Add(fq2.Mul(fq2.Square(x), x), bls.bn128.TwistCoefB)
+		if y, ok := bls.sqrtFq2(y2); ok {
+			return [3][2]*big.Int{x, y, fq2.One()}
+		}
+	}
+}
+
+func TestSubgroupCheckStrategies(t *testing.T) {
+	strategy := bls.SubgroupCheckStrategy()
+	if strategy != SubgroupCheckRMultiplication && strategy != SubgroupCheckFrobenius {
+		t.Fatalf("unexpected strategy %q", strategy)
+	}
+
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	points := [][3][2]*big.Int{bls.bn128.G2.G, keyPair.PubKey}
+	expected := []bool{true, true}
+	for i := 0; i < 3; i++ {
+		points = append(points, randomTwistPoint(t))
+		expected = append(expected, false)
+	}
+
+	for i, point := range points {
+		rMultiplication := bls.isInSubgroupG2RMultiplication(point)
+		frobenius := bls.isInSubgroupG2Frobenius(point)
+		if rMultiplication != expected[i] || frobenius != expected[i] {
+			t.Fatalf("point %d: expected %v, r-multiplication: %v, frobenius: %v", i, expected[i], rMultiplication, frobenius)
+		}
+		if bls.IsInSubgroupG2(point) != expected[i] {
+			t.Fatalf("point %d: selected strategy %q gave wrong result", i, strategy)
+		}
+	}
+}
+
+func TestVerifyBytesValidatesInputs(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+
+	offCurveSignature := [3]*big.Int{signature[0], new(big.Int).Add(signature[1], big.NewInt(1)), signature[2]}
+	if _, err := bls.VerifyBytes(offCurveSignature, keyPair.PubKey, tempMessage); !errors.Is(err, ErrNotOnCurve) {
+		t.Fatalf("expected ErrNotOnCurve for off curve signature, got: %v", err)
+	}
+
+	offSubgroupPubKey := randomTwistPoint(t)
+	if !bls.IsOnCurveG2(offSubgroupPubKey) {
+		t.Fatal("random twist point is not on curve")
+	}
+	if _, err := bls.VerifyBytes(signature, offSubgroupPubKey, tempMessage); !errors.Is(err, ErrNotInSubgroup) {
+		t.Fatalf("expected ErrNotInSubgroup for off subgroup pubKey, got: %v", err)
+	}
+
+	zeroSignature := [3]*big.Int{big.NewInt(0), big.NewInt(0), big.NewInt(0)}
+	if _, err := bls.VerifyBytes(zeroSignature, keyPair.PubKey, tempMessage); !errors.Is(err, ErrPointAtInfinity) {
+		t.Fatalf("expected ErrPointAtInfinity for zero signature, got: %v", err)
+	}
+	if _, err := bls.VerifyBytes(signature, bls.bn128.G2.Zero(), tempMessage); !errors.Is(err, ErrPointAtInfinity) {
+		t.Fatalf("expected ErrPointAtInfinity for zero pubKey, got: %v", err)
+	}
+}
+
+func TestNormalizePoint(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	signature, _ := bls.SignBytes(keyPair, tempMessage)
+	q := bls.bn128.Q
+
+	unreducedSignature := [3]*big.Int{new(big.Int).Add(signature[0], q), signature[1], signature[2]}
+	normalized, err := bls.NormalizePointG1(unreducedSignature, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if normalized[0].Cmp(signature[0]) != 0 || !bls.bn128.G1.Equal(normalized, signature) {
+		t.Fatal("coordinate was not reduced")
+	}
+	if _, err := bls.NormalizePointG1(unreducedSignature, true); !errors.Is(err, ErrNonCanonicalCoordinate) {
+		t.Fatalf("expected ErrNonCanonicalCoordinate, got: %v", err)
+	}
+	if _, err := bls.VerifyBytes(unreducedSignature, keyPair.PubKey, tempMessage); !errors.Is(err, ErrNonCanonicalCoordinate) {
+		t.Fatalf("expected VerifyBytes to reject unreduced signature, got: %v", err)
+	}
+
+	unreducedPubKey := keyPair.PubKey
+	unreducedPubKey[0] = [2]*big.Int{keyPair.PubKey[0][0], new(big.Int).Sub(keyPair.PubKey[0][1], q)}
+	normalizedPubKey, err := bls.NormalizePointG2(unreducedPubKey, false)
+	if err != nil || !bls.bn128.G2.Equal(normalizedPubKey, keyPair.PubKey) {
+		t.Fatalf("negative coordinate was not reduced, err: %v", err)
+	}
+	if _, err := bls.VerifyBytes(signature, unreducedPubKey, tempMessage); !errors.Is(err, ErrNonCanonicalCoordinate) {
+		t.Fatalf("expected VerifyBytes to reject unreduced pubKey, got: %v", err)
+	}
+
+	messageX, _ := new(big.Int).SetString(tempMessageX, 16)
+	unreducedMessageX := new(big.Int).Add(messageX, q).Text(16)
+	if _, err := bls.VerifySignature(signature, keyPair.PubKey, unreducedMessageX, tempMessageY); !errors.Is(err, ErrNonCanonicalCoordinate) {
+		t.Fatalf("expected VerifySignature to reject unreduced message coordinate, got: %v", err)
+	}
+}
+
+func TestClearCofactorG2(t *testing.T) {
+	expected := new(big.Int).Sub(new(big.Int).Lsh(bls.bn128.Q, 1), bls.bn128.R)
+	if CofactorG2().Cmp(expected) != 0 {
+		t.Fatal("expected cofactor to equal 2q - r")
+	}
+	if new(big.Int).GCD(nil, nil, CofactorG2(), bls.bn128.R).Cmp(big.NewInt(1)) != 0 {
+		t.Fatal("expected cofactor to be coprime to r")
+	}
+
+	point := randomTwistPoint(t)
+	if bls.IsInSubgroupG2(point) {
+		t.Fatal("expected random twist point to be outside subgroup")
+	}
+	cleared := bls.ClearCofactorG2(point)
+	if bls.bn128.G2.IsZero(cleared) || !bls.IsOnCurveG2(cleared) || !bls.IsInSubgroupG2(cleared) {
+		t.Fatal("expected cleared point to be in order-r subgroup")
+	}
+	if err := bls.ValidatePubKey(point); !errors.Is(err, ErrNotInSubgroup) {
+		t.Fatalf("expected raw twist point to be rejected as pubKey, got: %v", err)
+	}
+	if err := bls.ValidatePubKey(cleared); err != nil {
+		t.Fatalf("expected cleared point to be accepted as pubKey, got: %v", err)
+	}
+}
@@ -0,0 +1,153 @@
+package bn128_bls
+
+// Hash To G1 Following RFC 9380 hash_to_curve, Suite BN254G1_XMD:SHA-256_SVDW_RO_:
+// expand_message_xmd With SHA-256 Produces Two 48 Byte Strings, Each Reduced Modulo Q Into u0 And u1,
+// Both Are Mapped With Shallue-van de Woestijne Map (Section 6.6.1, Z = 1) And Resulting Points Are Added,
+// Cofactor Clearing Is No-Op Since G1 Cofactor Is 1. Configured DST Is Used As RFC 9380 DST.
+// Unlike Try-And-Increment, Map Runs In Fixed Number Of Field Operations.
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+)
+
+type HashToCurveMethod int
+
+const (
+	// Default, Keccak256 Digest Mapped By Try-And-Increment, See HashToG1. Cheap To Replicate On Ethereum.
+	HashToCurveTryAndIncrement HashToCurveMethod = iota
+	// RFC 9380 Hash To Curve With SVDW Map, See HashToG1SVDW.
+	HashToCurveSVDW
+)
+
+const (
+	// L = ceil((ceil(log2(Q)) + 128) / 8).
+	svdwFieldElementSize = 48
+	// SHA-256 Input Block Size (r_in_bytes).
+	sha256BlockSize = 64
+)
+
+func (method HashToCurveMethod) String() string {
+	switch method {
+	case HashToCurveTryAndIncrement:
+		return "try-and-increment"
+	case HashToCurveSVDW:
+		return "svdw"
+	}
+	return "unknown"
+}
+
+// Selects Method Used By HashToG1 And So By SignBytes, VerifyBytes And Everything Built On Them,
+// Signer And Verifier Must Agree On It. Returns ErrConfigLocked Once Instance Has Been Used To Sign Or Verify.
+func (bls *BLS) SetHashToCurve(method HashToCurveMethod) error {
+	if bls.used.Load() {
+		return ErrConfigLocked
+	}
+	if method != HashToCurveTryAndIncrement && method != HashToCurveSVDW {
+		return fmt.Errorf("unknown hash to curve method %d", method)
+	}
+	bls.hashToCurve = method
+	return nil
+}
+
+// Hashes `message` To G1 As Described In File Comment, Regardless Of Method Selected By SetHashToCurve.
+func (bls *BLS) HashToG1SVDW(message []byte) [3]*big.Int {
+	return bls.hashToG1XMD(message, bls.dst)
+}
+
+func (bls *BLS) hashToG1XMD(message []byte, dst []byte) [3]*big.Int {
+	bls.used.Store(true)
+	uniform := expandMessageXMD(message, dst, 2*svdwFieldElementSize)
+	u0 := new(big.Int).Mod(new(big.Int).SetBytes(uniform[:svdwFieldElementSize]), bls.bn128.Q)
+	u1 := new(big.Int).Mod(new(big.Int).SetBytes(uniform[svdwFieldElementSize:]), bls.bn128.Q)
+	return bls.bn128.G1.Add(bls.mapToG1SVDW(u0), bls.mapToG1SVDW(u1))
+}
+
+// expand_message_xmd Of RFC 9380 Section 5.3.1 Instantiated With SHA-256, `dst` Must Be At Most 255 Bytes.
+func expandMessageXMD(message []byte, dst []byte, size int) []byte {
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+	blocks := (size + sha256.Size - 1) / sha256.Size
+
+	hasher := sha256.New()
+	hasher.Write(make([]byte, sha256BlockSize))
+	hasher.Write(message)
+	hasher.Write([]byte{byte(size >> 8), byte(size), 0})
+	hasher.Write(dstPrime)
+	b0 := hasher.Sum(nil)
+
+	hasher.Reset()
+	hasher.Write(b0)
+	hasher.Write([]byte{1})
+	hasher.Write(dstPrime)
+	bi := hasher.Sum(nil)
+	uniform := append([]byte{}, bi...)
+	for i := 2; i <= blocks; i++ {
+		mixed := make([]byte, sha256.Size)
+		for j := range mixed {
+			mixed[j] = b0[j] ^ bi[j]
+		}
+		hasher.Reset()
+		hasher.Write(mixed)
+		hasher.Write([]byte{byte(i)})
+		hasher.Write(dstPrime)
+		bi = hasher.Sum(nil)
+		uniform = append(uniform, bi...)
+	}
+	return uniform[:size]
+}
+
+// Returns c2 = -Z/2, c3 = sqrt(-g(Z)·3Z^2) With sgn0(c3) = 0 And c4 = -4g(Z)/(3Z^2) For Z = 1, A = 0, B = 3.
+func (bls *BLS) svdwConstantsTable() [3]*big.Int {
+	bls.tables.svdwOnce.Do(func() {
+		fq := bls.bn128.Fq1
+		three := big.NewInt(3)
+		c2 := fq.Neg(fq.Inverse(big.NewInt(2)))
+		c3 := new(big.Int).ModSqrt(fq.Neg(big.NewInt(12)), bls.bn128.Q)
+		if c3.Bit(0) == 1 {
+			c3 = fq.Neg(c3)
+		}
+		c4 := fq.Neg(fq.Mul(big.NewInt(16), fq.Inverse(three)))
+		bls.tables.svdwConstants = [3]*big.Int{c2, c3, c4}
+	})
+	return bls.tables.svdwConstants
+}
+
+// map_to_curve_svdw Of RFC 9380 Section 6.6.1 For y^2 = x^3 + 3 With Z = 1, `u` Must Be Reduced Modulo Q.
+func (bls *BLS) mapToG1SVDW(u *big.Int) [3]*big.Int {
+	fq := bls.bn128.Fq1
+	constants := bls.svdwConstantsTable()
+	c1, c2, c3, c4 := big.NewInt(4), constants[0], constants[1], constants[2]
+	g := func(x *big.Int) *big.Int {
+		return fq.Add(fq.Mul(fq.Square(x), x), bls.bn128.CoefB)
+	}
+	isSquare := func(x *big.Int) bool {
+		return big.Jacobi(x, bls.bn128.Q) >= 0
+	}
+
+	tv1 := fq.Mul(fq.Square(u), c1)
+	tv2 := fq.Add(fq.One(), tv1)
+	tv1 = fq.Sub(fq.One(), tv1)
+	tv3 := fq.Mul(tv1, tv2)
+	// inv0(0) = 0.
+	if tv3.Sign() != 0 {
+		tv3 = fq.Inverse(tv3)
+	}
+	tv4 := fq.Mul(fq.Mul(fq.Mul(u, tv1), tv3), c3)
+
+	var x *big.Int
+	if x1 := fq.Sub(c2, tv4); isSquare(g(x1)) {
+		x = x1
+	} else if x2 := fq.Add(c2, tv4); isSquare(g(x2)) {
+		x = x2
+	} else {
+		x3 := fq.Square(fq.Mul(fq.Square(tv2), tv3))
+		x = fq.Add(fq.Mul(x3, c4), fq.One())
+	}
+
+	y := new(big.Int).ModSqrt(g(x), bls.bn128.Q)
+	if y.Bit(0) != u.Bit(0) {
+		y = fq.Neg(y)
+	}
+	return [3]*big.Int{x, y, fq.One()}
+}
@@ -0,0 +1,72 @@
+package bn128_bls
+
+import (
+	"math/big"
+	"testing"
+)
+
+// Reference Points Computed With gnark-crypto v0.12.1 (bn254.MapToG1 And bn254.HashToG1).
+func TestHashToG1SVDW(t *testing.T) {
+	const dst = "QUUX-V01-CS02-with-BN254G1_XMD:SHA-256_SVDW_RO_"
+	svdw := NewBls()
+	if err := svdw.SetDST([]byte(dst)); err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		message string
+		x, y    string
+	}{
+		{"", "4790658965958450548702669593570794336562317867247372723806336874591549759110", "1163238807669877429342450210709044731909255047583162173012265677391336920021"},
+		{"abc", "16267524812466668166267883771992486438338357688076900798565538061554532963281", "1844916233815282837483764409618609279507070495361570126601873459268232811805"},
+	}
+	for _, c := range cases {
+		point := svdw.bn128.G1.Affine(svdw.HashToG1SVDW([]byte(c.message)))
+		if point[0].String() != c.x || point[1].String() != c.y {
+			t.Fatalf("%q: got (%v, %v)", c.message, point[0], point[1])
+		}
+	}
+
+	mapped := svdw.mapToG1SVDW(big.NewInt(12345))
+	if mapped[0].String() != "14807398319772818147706751964292400895657534564426402573431268103662547447865" ||
+		mapped[1].String() != "860007594726849708287460790593452309708724813346880493564903007287497805137" {
+		t.Fatalf("map(12345): got (%v, %v)", mapped[0], mapped[1])
+	}
+	// u = 0 Hits inv0(0) Branch.
+	mapped = svdw.mapToG1SVDW(big.NewInt(0))
+	if mapped[0].String() != "10944121435919637611123202872628637544348155578648911831344518947322613104291" ||
+		mapped[1].String() != "4718603453640367770405249522358112449463417117041194427604452040985121683380" {
+		t.Fatalf("map(0): got (%v, %v)", mapped[0], mapped[1])
+	}
+}
+
+func TestSetHashToCurve(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	tryAndIncrement, svdw := NewBls(), NewBls()
+	if err := svdw.SetHashToCurve(HashToCurveSVDW); err != nil {
+		t.Fatal(err)
+	}
+	fingerprintTAI, _ := tryAndIncrement.ConfigFingerprint()
+	fingerprintSVDW, _ := svdw.ConfigFingerprint()
+	if fingerprintTAI == fingerprintSVDW {
+		t.Fatal("expected config fingerprints to differ")
+	}
+
+	signature, _ := svdw.SignBytes(keyPair, tempMessage)
+	if ok, _ := svdw.VerifyBytes(signature, keyPair.PubKey, tempMessage); !ok {
+		t.Fatal("expected svdw signature to verify under svdw")
+	}
+	if ok, _ := tryAndIncrement.VerifyBytes(signature, keyPair.PubKey, tempMessage); ok {
+		t.Fatal("expected svdw signature to fail under try-and-increment")
+	}
+	signature, _ = tryAndIncrement.SignBytes(keyPair, tempMessage)
+	if ok, _ := svdw.VerifyBytes(signature, keyPair.PubKey, tempMessage); ok {
+		t.Fatal("expected try-and-increment signature to fail under svdw")
+	}
+
+	if err := svdw.SetHashToCurve(HashToCurveTryAndIncrement); err != ErrConfigLocked {
+		t.Fatalf("expected ErrConfigLocked, got %v", err)
+	}
+	if err := NewBls().SetHashToCurve(HashToCurveMethod(7)); err == nil {
+		t.Fatal("expected error for unknown method")
+	}
+}
@@ -0,0 +1,198 @@
+package bn128_bls
+
+// Threshold Signatures Using Shamir Secret Sharing Of Private Key Over Scalar Field,
+// Any `threshold` Partial Signatures Combine (Lagrange Interpolation At 0) Into Signature Valid Under Group PubKey.
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Share Of Group Private Key, Index Is Evaluation Point Of Sharing Polynomial And Starts At 1.
+type KeyShare struct {
+	Index   int
+	KeyPair *KeyPair
+}
+
+// Partial Signature Of Key Share, MessageDigest Is MessageDigest Of Signed Message And Lets CombineSignatures
+// Detect Partials Signed Over Different Messages.
+type PartialSignature struct {
+	Index         int
+	Signature     [3]*big.Int
+	MessageDigest [32]byte
+}
+
+var ErrInconsistentPartials = errors.New("partial signatures are over different messages")
+
+// Splits `privateKey` Into `shares` Shares, Any `threshold` Of Which Can Produce Signature Under Group PubKey (privateKey·G2).
+func (bls *BLS) SplitPrivateKey(privateKey *big.Int, threshold int, shares int) ([]KeyShare, error) {
+	keyShares, _, err := bls.splitPrivateKey(privateKey, threshold, shares)
+	return keyShares, err
+}
+
+// Same As SplitPrivateKey But Also Returns Feldman Commitments [a_j]G2 To Sharing Polynomial Coefficients,
+// commitments[0] Is Group PubKey, See GroupPublicKeyFromCommitments And VerifyKeyShare.
+func (bls *BLS) SplitPrivateKeyWithCommitments(privateKey *big.Int, threshold int, shares int) ([]KeyShare, [][3][2]*big.Int, error) {
+	keyShares, coefficients, err := bls.splitPrivateKey(privateKey, threshold, shares)
+	if err != nil {
+		return nil, nil, err
+	}
+	commitments := make([][3][2]*big.Int, len(coefficients))
+	for j, coefficient := range coefficients {
+		commitments[j] = bls.mulBaseG2(coefficient)
+	}
+	return keyShares, commitments, nil
+}
+
+func (bls *BLS) splitPrivateKey(privateKey *big.Int, threshold int, shares int) ([]KeyShare, []*big.Int, error) {
+	if threshold < 1 || threshold > shares {
+		return nil, nil, fmt.Errorf("invalid threshold %d for %d shares", threshold, shares)
+	}
+	coefficients := []*big.Int{new(big.Int).Mod(privateKey, curveOrder)}
+	for i := 1; i < threshold; i++ {
+		coefficient, err := randomScalar(rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+		coefficients = append(coefficients, coefficient)
+	}
+
+	keyShares := make([]KeyShare, shares)
+	for i := range keyShares {
+		index := big.NewInt(int64(i + 1))
+		// Horner Evaluation Of Sharing Polynomial At index.
+		value := new(big.Int)
+		for j := len(coefficients) - 1; j >= 0; j-- {
+			value.Mul(value, index).Add(value, coefficients[j]).Mod(value, curveOrder)
+		}
+		keyShares[i] = KeyShare{
+			Index: i + 1,
+			KeyPair: &KeyPair{
+				PrivateKey: value,
+				PubKey:     bls.mulBaseG2(value),
+				PubKeyG1:   bls.mulBaseG1(value),
+			},
+		}
+	}
+	return keyShares, coefficients, nil
+}
+
+// Returns Group PubKey Of DKG Or SplitPrivateKeyWithCommitments, Which Is Commitment To Constant Term (commitments[0]),
+// Lets Participants Agree On It Without Reconstructing Secret. It Is Validated Like Any PubKey.
+func (bls *BLS) GroupPublicKeyFromCommitments(commitments [][3][2]*big.Int) ([3][2]*big.Int, error) {
+	if len(commitments) < 1 {
+		return [3][2]*big.Int{}, fmt.Errorf("no commitment have been passed")
+	}
+	if err := bls.ValidatePubKey(commitments[0]); err != nil {
+		return [3][2]*big.Int{}, fmt.Errorf("invalid group pubKey commitment: %w", err)
+	}
+	return CloneG2(commitments[0]), nil
+}
+
+// Checks Share PubKey Against Feldman Commitments: pubKeyG2 == Σ index^j·commitments[j].
+func (bls *BLS) VerifyKeyShare(index int, pubKeyG2 [3][2]*big.Int, commitments [][3][2]*big.Int) (bool, error) {
+	if index < 1 {
+		return false, fmt.Errorf("invalid key share index %d", index)
+	}
+	if len(commitments) < 1 {
+		return false, fmt.Errorf("no commitment have been passed")
+	}
+	if err := bls.ValidatePubKey(pubKeyG2); err != nil {
+		return false, fmt.Errorf("invalid pubKey: %w", err)
+	}
+	expected := bls.bn128.G2.Zero()
+	for j := len(commitments) - 1; j >= 0; j-- {
+		if err := bls.ValidatePubKey(commitments[j]); err != nil {
+			return false, fmt.Errorf("invalid commitment at index %d: %w", j, err)
+		}
+		expected = bls.addG2(bls.bn128.G2.MulScalar(expected, big.NewInt(int64(index))), commitments[j])
+	}
+	return bls.bn128.G2.Equal(expected, pubKeyG2), nil
+}
+
+// Signs `message` With Key Share.
+func (bls *BLS) SignPartial(keyShare KeyShare, message []byte) (PartialSignature, error) {
+	signature, err := bls.SignBytes(keyShare.KeyPair, message)
+	if err != nil {
+		return PartialSignature{}, err
+	}
+	return PartialSignature{Index: keyShare.Index, Signature: signature, MessageDigest: bls.MessageDigest(message)}, nil
+}
+
+// Combines Partial Signatures With Distinct Indexes Into Group Signature, Caller Must Pass At Least `threshold` Of Them.
+// Returns ErrInconsistentPartials If MessageDigest Of Partials Differ.
+func (bls *BLS) CombineSignatures(partials []PartialSignature) ([3]*big.Int, error) {
+	if len(partials) < 1 {
+		return [3]*big.Int{}, fmt.Errorf("no partial signature have been passed")
+	}
+	indices := make([]int, len(partials))
+	for i, partial := range partials {
+		if partial.MessageDigest != partials[0].MessageDigest {
+			return [3]*big.Int{}, fmt.Errorf("%w: partial %d has digest %x, partial %d has %x", ErrInconsistentPartials, partial.Index, partial.MessageDigest, partials[0].Index, partials[0].MessageDigest)
+		}
+		if err := bls.ValidateSignature(partial.Signature); err != nil {
+			return [3]*big.Int{}, fmt.Errorf("invalid partial signature %d: %w", partial.Index, err)
+		}
+		indices[i] = partial.Index
+	}
+	lambdas, err := LagrangeCoefficients(indices)
+	if err != nil {
+		return [3]*big.Int{}, err
+	}
+
+	combined := bls.zeroG1()
+	for i, partial := range partials {
+		combined = bls.bn128.G1.Add(combined, bls.bn128.G1.MulScalar(partial.Signature, lambdas[i]))
+	}
+	return combined, nil
+}
+
+// Returns Lagrange Coefficients Mod R For Interpolation At x = 0 Over Share `indices`, In Same Order,
+// Coefficient i Is λ_i = Π_{j≠i} x_j / (x_j - x_i), Indices Must Be Distinct And At Least 1.
+func LagrangeCoefficients(indices []int) ([]*big.Int, error) {
+	if len(indices) < 1 {
+		return nil, fmt.Errorf("no indices have been passed")
+	}
+	seen := map[int]bool{}
+	for _, index := range indices {
+		if index < 1 {
+			return nil, fmt.Errorf("invalid share index %d", index)
+		}
+		if seen[index] {
+			return nil, fmt.Errorf("duplicate share index %d", index)
+		}
+		seen[index] = true
+	}
+
+	lambdas := make([]*big.Int, len(indices))
+	for i, index := range indices {
+		numerator, denominator := big.NewInt(1), big.NewInt(1)
+		for j, other := range indices {
+			if i == j {
+				continue
+			}
+			numerator.Mul(numerator, big.NewInt(int64(other))).Mod(numerator, curveOrder)
+			denominator.Mul(denominator, big.NewInt(int64(other-index))).Mod(denominator, curveOrder)
+		}
+		lambdas[i] = numerator.Mul(numerator, denominator.ModInverse(denominator, curveOrder)).Mod(numerator, curveOrder)
+	}
+	return lambdas, nil
+}
+
+// Verifies `combined` Under `groupPubKeyG2` And Requires At Least `threshold` Distinct `participants`,
+// Returning ErrQuorumNotMet Even If Signature Itself Is Valid.
+func (bls *BLS) VerifyThreshold(combined [3]*big.Int, groupPubKeyG2 [3][2]*big.Int, message []byte, participants []int, threshold int) (bool, error) {
+	if threshold < 1 {
+		return false, fmt.Errorf("invalid threshold %d", threshold)
+	}
+	distinct := map[int]bool{}
+	for _, participant := range participants {
+		distinct[participant] = true
+	}
+	if len(distinct) < threshold {
+		return false, fmt.Errorf("%w: %d participants, threshold is %d", ErrQuorumNotMet, len(distinct), threshold)
+	}
+	return bls.VerifyBytes(combined, groupPubKeyG2, message)
+}
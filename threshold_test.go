@@ -0,0 +1,134 @@
+package bn128_bls
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestVerifyThreshold(t *testing.T) {
+	groupKeyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	keyShares, err := bls.SplitPrivateKey(groupKeyPair.PrivateKey, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	partials := []PartialSignature{}
+	participants := []int{}
+	for _, keyShare := range []KeyShare{keyShares[4], keyShares[1], keyShares[2]} {
+		partial, err := bls.SignPartial(keyShare, tempMessage)
+		if err != nil {
+			t.Fatal(err)
+		}
+		partials = append(partials, partial)
+		participants = append(participants, partial.Index)
+	}
+	combined, err := bls.CombineSignatures(partials)
+	if err != nil {
+		t.Fatal(err)
+	}
+	direct, _ := bls.SignBytes(groupKeyPair, tempMessage)
+	if !bls.bn128.G1.Equal(combined, direct) {
+		t.Fatal("combined signature does not match signature of group key")
+	}
+
+	ok, err := bls.VerifyThreshold(combined, groupKeyPair.PubKey, tempMessage, participants, 3)
+	if err != nil || !ok {
+		t.Fatalf("expected threshold signature to verify, ok: %v, err: %v", ok, err)
+	}
+	if _, err := bls.VerifyThreshold(combined, groupKeyPair.PubKey, tempMessage, participants, 4); !errors.Is(err, ErrQuorumNotMet) {
+		t.Fatalf("expected ErrQuorumNotMet, got: %v", err)
+	}
+	if _, err := bls.VerifyThreshold(combined, groupKeyPair.PubKey, tempMessage, []int{1, 1, 2}, 3); !errors.Is(err, ErrQuorumNotMet) {
+		t.Fatalf("expected duplicate participants not to count, got: %v", err)
+	}
+
+	if _, err := bls.CombineSignatures(append(partials, partials[0])); err == nil {
+		t.Fatal("expected error for duplicate partial index")
+	}
+}
+
+func TestCombineInconsistentPartials(t *testing.T) {
+	groupKeyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	keyShares, _ := bls.SplitPrivateKey(groupKeyPair.PrivateKey, 2, 3)
+	partial0, _ := bls.SignPartial(keyShares[0], tempMessage)
+	partial1, _ := bls.SignPartial(keyShares[1], []byte("faulty signer message"))
+	if partial0.MessageDigest != bls.MessageDigest(tempMessage) {
+		t.Fatal("expected partial to record message digest")
+	}
+	if _, err := bls.CombineSignatures([]PartialSignature{partial0, partial1}); !errors.Is(err, ErrInconsistentPartials) {
+		t.Fatalf("expected ErrInconsistentPartials, got: %v", err)
+	}
+}
+
+func TestGroupPublicKeyFromCommitments(t *testing.T) {
+	groupKeyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	keyShares, commitments, err := bls.SplitPrivateKeyWithCommitments(groupKeyPair.PrivateKey, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	groupPubKeyG2, err := bls.GroupPublicKeyFromCommitments(commitments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bls.bn128.G2.Equal(groupPubKeyG2, groupKeyPair.PubKey) {
+		t.Fatal("expected commitment to constant term to equal group pubKey")
+	}
+	for _, keyShare := range keyShares {
+		if ok, err := bls.VerifyKeyShare(keyShare.Index, keyShare.KeyPair.PubKey, commitments); err != nil || !ok {
+			t.Fatalf("share %d: expected to match commitments, ok: %v, err: %v", keyShare.Index, ok, err)
+		}
+	}
+	if ok, _ := bls.VerifyKeyShare(keyShares[0].Index, keyShares[1].KeyPair.PubKey, commitments); ok {
+		t.Fatal("expected share pubKey at wrong index to fail")
+	}
+	// Polynomial With Equal Coefficients, Horner Step At Index 1 Adds Commitment To Itself.
+	equalCommitments := [][3][2]*big.Int{groupKeyPair.PubKey, groupKeyPair.PubKey}
+	if ok, err := bls.VerifyKeyShare(1, bls.bn128.G2.Double(groupKeyPair.PubKey), equalCommitments); err != nil || !ok {
+		t.Fatalf("expected share of equal coefficients to match commitments, ok: %v, err: %v", ok, err)
+	}
+
+	partials := []PartialSignature{}
+	for _, keyShare := range keyShares[1:] {
+		partial, _ := bls.SignPartial(keyShare, tempMessage)
+		partials = append(partials, partial)
+	}
+	combined, _ := bls.CombineSignatures(partials)
+	if ok, err := bls.VerifyBytes(combined, groupPubKeyG2, tempMessage); err != nil || !ok {
+		t.Fatalf("expected combined signature to verify under derived group key, ok: %v, err: %v", ok, err)
+	}
+
+	if _, err := bls.GroupPublicKeyFromCommitments([][3][2]*big.Int{randomTwistPoint(t)}); !errors.Is(err, ErrNotInSubgroup) {
+		t.Fatalf("expected ErrNotInSubgroup, got %v", err)
+	}
+}
+
+func TestLagrangeCoefficients(t *testing.T) {
+	groupKeyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	keyShares, _ := bls.SplitPrivateKey(groupKeyPair.PrivateKey, 3, 5)
+	subset := []KeyShare{keyShares[0], keyShares[3], keyShares[4]}
+	indices := []int{}
+	for _, keyShare := range subset {
+		indices = append(indices, keyShare.Index)
+	}
+	lambdas, err := LagrangeCoefficients(indices)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	combined := bls.zeroG1()
+	for i, keyShare := range subset {
+		partial, _ := bls.SignPartial(keyShare, tempMessage)
+		combined = bls.bn128.G1.Add(combined, bls.bn128.G1.MulScalar(partial.Signature, lambdas[i]))
+	}
+	direct, _ := bls.SignBytes(groupKeyPair, tempMessage)
+	if !bls.bn128.G1.Equal(combined, direct) {
+		t.Fatal("signature reconstructed with coefficients does not match signature of group key")
+	}
+
+	for _, indices := range [][]int{{}, {0, 1}, {-1, 2}, {1, 2, 1}} {
+		if _, err := LagrangeCoefficients(indices); err == nil {
+			t.Fatalf("expected error for indices %v", indices)
+		}
+	}
+}
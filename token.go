@@ -0,0 +1,51 @@
+package bn128_bls
+
+// Self-Verifying Bearer Tokens: Base64url (No Padding) Of claims || PubKey (128 Bytes, EIP-197 Order) || Signature (64 Bytes).
+// Signature Covers `claims` Hashed Under Separate Token DST "BN128_BLS_TOKEN_" || DST, So It Is Not Interchangeable
+// With SignBytes Signature Over Any Bytes.
+// Note: Token Only Proves Claims Were Signed By Embedded PubKey, Callers Must Still Decide Whether They Trust That PubKey.
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+const tokenDSTPrefix = "BN128_BLS_TOKEN_"
+
+// Signs `claims` And Packs Them Together With Signer PubKey And Signature.
+func (bls *BLS) CreateToken(keyPair *KeyPair, claims []byte) (string, error) {
+	signature, err := bls.signPoint(keyPair, bls.hashToG1ForPurpose(tokenDSTPrefix, claims))
+	if err != nil {
+		return "", err
+	}
+	data := make([]byte, 0, len(claims)+PubKeySize+SignatureSize)
+	data = append(data, claims...)
+	data = append(data, bls.PubKeyToBytes(keyPair.PubKey)...)
+	data = append(data, bls.SignatureToBytes(signature)...)
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Parses Token Made By CreateToken And Verifies Embedded Signature Against Embedded PubKey, Claims Are Returned Only If Valid.
+func (bls *BLS) VerifyToken(token string) ([]byte, bool, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decode token: %v", err)
+	}
+	if len(data) < PubKeySize+SignatureSize {
+		return nil, false, fmt.Errorf("token is too short")
+	}
+	claimsSize := len(data) - PubKeySize - SignatureSize
+	pubKey, err := bls.PubKeyFromBytes(data[claimsSize : claimsSize+PubKeySize])
+	if err != nil {
+		return nil, false, err
+	}
+	signature, err := bls.SignatureFromBytes(data[claimsSize+PubKeySize:])
+	if err != nil {
+		return nil, false, err
+	}
+	claims := data[:claimsSize]
+	if !bls.verifyPoint(signature, pubKey, bls.hashToG1ForPurpose(tokenDSTPrefix, claims)) {
+		return nil, false, nil
+	}
+	return claims, true, nil
+}
@@ -0,0 +1,43 @@
+package bn128_bls
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+)
+
+func TestToken(t *testing.T) {
+	keyPair, _ := bls.NewKeyPair("cb7b14116125dcd2b99e5db4f95ff277c6e46c7b3302b4efa389af0d7801672f")
+	claims := []byte(`{"sub":"alice","exp":1700000000}`)
+	token, err := bls.CreateToken(keyPair, claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsedClaims, ok, err := bls.VerifyToken(token)
+	if err != nil || !ok || !bytes.Equal(parsedClaims, claims) {
+		t.Fatalf("expected token to verify, ok: %v, err: %v", ok, err)
+	}
+
+	data, _ := base64.RawURLEncoding.DecodeString(token)
+	data[len(`{"sub":"`)] = 'm'
+	parsedClaims, ok, err = bls.VerifyToken(base64.RawURLEncoding.EncodeToString(data))
+	if err != nil || ok || parsedClaims != nil {
+		t.Fatalf("expected tampered claims to be rejected, ok: %v, err: %v", ok, err)
+	}
+
+	if _, _, err := bls.VerifyToken(token[:10]); err == nil {
+		t.Fatal("expected error for truncated token")
+	}
+
+	// Signing Service Which Signs Caller-Chosen Bytes With SignBytes Must Not Be Usable As Token Issuer.
+	signature, _ := bls.SignBytes(keyPair, append([]byte("BN128_BLS_TOKEN_"), claims...))
+	forged := append(append(append([]byte{}, claims...), bls.PubKeyToBytes(keyPair.PubKey)...), bls.SignatureToBytes(signature)...)
+	if _, ok, _ := bls.VerifyToken(base64.RawURLEncoding.EncodeToString(forged)); ok {
+		t.Fatal("expected plain SignBytes signature not to form valid token")
+	}
+	tokenSignature, _ := bls.SignatureFromBytes(data[len(data)-SignatureSize:])
+	if ok, _ := bls.VerifyBytes(tokenSignature, keyPair.PubKey, claims); ok {
+		t.Fatal("expected token signature not to verify as plain SignBytes signature")
+	}
+}
@@ -0,0 +1,62 @@
+package bn128_bls
+
+// Per-Input Trust Markers For Mixed Pipelines, Where Some PubKeys Are Generated Internally And Others Come From Network.
+// Marked Points Skip Only Costly G2 Subgroup Check, Coordinate Range, On-Curve And Infinity Checks Still Run.
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// G2 Point With Trust Marker, Trusted Must Only Be Set For Points Already Validated By Caller (e.g., Using ValidatePubKey).
+type TrustedPoint struct {
+	Point   [3][2]*big.Int
+	Trusted bool
+}
+
+// Marks `point` As Pre-Validated.
+func MarkTrusted(point [3][2]*big.Int) TrustedPoint {
+	return TrustedPoint{Point: point, Trusted: true}
+}
+
+// Wraps `point` Without Trust Marker, So It Gets Fully Validated.
+func MarkUntrusted(point [3][2]*big.Int) TrustedPoint {
+	return TrustedPoint{Point: point}
+}
+
+// Same As VerifyBytes, Skipping Subgroup Check Of `signerPubKey` If It Is Marked Trusted.
+func (bls *BLS) VerifyBytesMarked(signature [3]*big.Int, signerPubKey TrustedPoint, message []byte) (bool, error) {
+	if err := bls.ValidateSignature(signature); err != nil {
+		return false, fmt.Errorf("invalid signature: %w", err)
+	}
+	if err := bls.validatePubKey(signerPubKey.Point, !signerPubKey.Trusted); err != nil {
+		return false, fmt.Errorf("invalid signerPubKey: %w", err)
+	}
+	return bls.verifyPoint(signature, signerPubKey.Point, bls.HashToG1(message)), nil
+}
+
+// Same As FastAggregateVerify, Skipping Subgroup Check Of PubKeys Marked Trusted.
+func (bls *BLS) FastAggregateVerifyMarked(aggSig [3]*big.Int, pubKeysG2 []TrustedPoint, message []byte) (bool, error) {
+	if len(pubKeysG2) == 0 {
+		return bls.verifyEmptyAggregate(aggSig), nil
+	}
+	points := make([][3][2]*big.Int, len(pubKeysG2))
+	for i, pubKey := range pubKeysG2 {
+		if err := bls.validatePubKey(pubKey.Point, !pubKey.Trusted); err != nil {
+			return false, fmt.Errorf("invalid pubKey at index %d: %w", i, err)
+		}
+		points[i] = pubKey.Point
+	}
+	if err := bls.ValidateSignature(aggSig); err != nil {
+		return false, fmt.Errorf("invalid aggSig: %w", err)
+	}
+	aggPubKeyG2, err := bls.aggregatePubKeysG2(points)
+	if err != nil {
+		return false, fmt.Errorf("failed to aggregate pubKeys: %v", err)
+	}
+	// Aggregate Of Subgroup Points Stays In Subgroup, So Only Infinity Needs To Be Rejected Here.
+	if bls.bn128.G2.IsZero(aggPubKeyG2) {
+		return false, fmt.Errorf("invalid aggregate pubKey: %w", ErrPointAtInfinity)
+	}
+	return bls.verifyPoint(aggSig, aggPubKeyG2, bls.HashToG1(message)), nil
+}
@@ -0,0 +1,36 @@
+package bn128_bls
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestTrustedPointSkipsSubgroupCheck(t *testing.T) {
+	keyPairs, pubKeys := tempCommittee(t)
+	keyPairs, pubKeys = keyPairs[:3], pubKeys[:3]
+	aggSig, _ := bls.AggregateSignatures(signAll(t, keyPairs, tempMessage))
+
+	countingBls := NewBls()
+	checked := [][3][2]*big.Int{}
+	countingBls.subgroupCheckHook = func(point [3][2]*big.Int) {
+		checked = append(checked, point)
+	}
+
+	marked := []TrustedPoint{MarkTrusted(pubKeys[0]), MarkUntrusted(pubKeys[1]), MarkTrusted(pubKeys[2])}
+	ok, err := countingBls.FastAggregateVerifyMarked(aggSig, marked, tempMessage)
+	if err != nil || !ok {
+		t.Fatalf("expected aggregate to verify, ok: %v, err: %v", ok, err)
+	}
+	if len(checked) != 1 || !countingBls.bn128.G2.Equal(checked[0], pubKeys[1]) {
+		t.Fatalf("expected only untrusted pubKey to be subgroup checked, got %d checks", len(checked))
+	}
+
+	checked = nil
+	offSubgroupPubKey := randomTwistPoint(t)
+	if _, err := countingBls.VerifyBytesMarked(aggSig, MarkUntrusted(offSubgroupPubKey), tempMessage); err == nil {
+		t.Fatal("expected untrusted off-subgroup pubKey to be rejected")
+	}
+	if len(checked) != 1 {
+		t.Fatalf("expected 1 subgroup check, got %d", len(checked))
+	}
+}